@@ -6,6 +6,12 @@ let username = params.username
 let password = params.password
 let vid = params.vid
 
+// Set by the agent when logging in through a one-time login link
+if (typeof guacLogin !== 'undefined') {
+    username = guacLogin.username
+    password = guacLogin.password
+}
+
 let payload = new URLSearchParams({
     'username': username,
     'password': password
@@ -13,7 +19,7 @@ let payload = new URLSearchParams({
 
 console.log(payload, JSON.stringify(payload));
 
-fetch("/guacamole/api/tokens" , {
+fetch(guacBase + "/api/tokens" , {
     method: 'POST',
     headers:{
         'Content-Type': 'application/x-www-form-urlencoded'
@@ -31,9 +37,9 @@ fetch("/guacamole/api/tokens" , {
     localStorage.removeItem('GUAC_PREFERENCES')
     console.log("Sending to vm with id: " + vid);
     if (vid == null) {
-        window.location.replace("/guacamole/#");
+        window.location.replace(guacBase + "/#");
     } else {
-        window.location.replace("/guacamole/#/client/" + vid);
+        window.location.replace(guacBase + "/#/client/" + vid);
     }
     
 })
\ No newline at end of file
@@ -0,0 +1,39 @@
+const term = new Terminal({ cursorBlink: true });
+const fitAddon = new FitAddon.FitAddon();
+term.loadAddon(fitAddon);
+term.open(document.getElementById('terminal'));
+fitAddon.fit();
+
+const protocol = window.location.protocol === 'https:' ? 'wss://' : 'ws://';
+const socket = new WebSocket(protocol + window.location.host + wsPath + window.location.search);
+socket.binaryType = 'arraybuffer';
+
+function sendResize() {
+    if (socket.readyState === WebSocket.OPEN) {
+        socket.send(JSON.stringify({ type: 'resize', cols: term.cols, rows: term.rows }));
+    }
+}
+
+socket.onopen = function() {
+    sendResize();
+    term.focus();
+}
+
+socket.onmessage = function(event) {
+    term.write(new Uint8Array(event.data));
+}
+
+socket.onclose = function() {
+    term.write('\r\n\r\nConnection to the terminal was closed\r\n');
+}
+
+term.onData(function(data) {
+    if (socket.readyState === WebSocket.OPEN) {
+        socket.send(JSON.stringify({ type: 'input', data: data }));
+    }
+});
+
+window.addEventListener('resize', function() {
+    fitAddon.fit();
+    sendResize();
+});
@@ -29,6 +29,11 @@ date=$(date)
 go build --ldflags "-X 'main.version=$1' -X 'main.compileDate=$date'" -o release/haaukins-agent-$1-linux-64bit
 chmod +x release/haaukins-agent-$1-linux-64bit
 
+# Download vendored browser libraries if they have not been committed
+if [ ! -f assets/vendor/xterm/xterm.js ]; then
+  ./fetchAssets.sh
+fi
+
 # cp assets folder to release
 cp -r assets release/
 
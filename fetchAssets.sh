@@ -0,0 +1,23 @@
+#!/bin/bash
+
+# This script downloads the third party browser libraries served from ./assets/vendor,
+# so pages do not load scripts from a CDN. Run it when bumping a version and commit the files.
+set -e
+
+XTERM_VERSION=5.3.0
+XTERM_ADDON_FIT_VERSION=0.8.0
+
+dir=assets/vendor/xterm
+tmp=$(mktemp -d)
+trap 'rm -rf "$tmp"' EXIT
+
+mkdir -p $dir
+
+echo "Downloading xterm $XTERM_VERSION"
+curl -fsSL https://registry.npmjs.org/xterm/-/xterm-$XTERM_VERSION.tgz | tar -xz -C "$tmp"
+cp "$tmp/package/lib/xterm.js" "$tmp/package/css/xterm.css" $dir/
+rm -rf "$tmp/package"
+
+echo "Downloading xterm-addon-fit $XTERM_ADDON_FIT_VERSION"
+curl -fsSL https://registry.npmjs.org/xterm-addon-fit/-/xterm-addon-fit-$XTERM_ADDON_FIT_VERSION.tgz | tar -xz -C "$tmp"
+cp "$tmp/package/lib/xterm-addon-fit.js" $dir/
@@ -2,11 +2,14 @@ package agent
 
 import (
 	"context"
+	"crypto/tls"
 	"errors"
+	"fmt"
 	"io/ioutil"
 	"os"
 	"path/filepath"
 	"sync"
+	"time"
 
 	"github.com/aau-network-security/haaukins-agent/internal/state"
 	"google.golang.org/grpc"
@@ -15,6 +18,7 @@ import (
 	"github.com/aau-network-security/haaukins-agent/internal/environment/lab/virtual"
 	"github.com/aau-network-security/haaukins-agent/internal/worker"
 	pb "github.com/aau-network-security/haaukins-agent/pkg/proto"
+	"github.com/rs/zerolog"
 	"github.com/rs/zerolog/log"
 	"gopkg.in/yaml.v2"
 )
@@ -27,9 +31,16 @@ type Agent struct {
 	auth   Authenticator
 	vlib   *virtual.VboxLibrary
 	pb.UnimplementedAgentServer
-	workerPool worker.WorkerPool
-	newLabs    chan pb.Lab
-	EnvPool    *env.EnvPool `json:"envpool,omitempty"`
+	workerPool  worker.WorkerPool
+	newLabs     *labFeed
+	loginTokens *loginTokenStore
+	limiter     *proxyLimiter
+	tlsConfig   *tls.Config
+	auditLog    *auditLogger
+	labUsage    *labUsageStore
+	logLevel    *logLevelControl
+	build       BuildInfo
+	EnvPool     *env.EnvPool `json:"envpool,omitempty"`
 }
 
 const DEFAULT_SIGN = "dev-sign-key"
@@ -59,6 +70,15 @@ func NewConfigFromFile(path string) (*Config, error) {
 		c.GrpcPort = 50095
 	}
 
+	if c.MetricsPort != 0 && c.MetricsListen == "" {
+		c.MetricsListen = "127.0.0.1"
+	}
+
+	if c.Gateway.Enabled && c.Gateway.Port == 0 {
+		log.Debug().Int("port", 50096).Msg("gateway port not provided in the configuration file using default")
+		c.Gateway.Port = 50096
+	}
+
 	if c.SignKey == "" {
 		log.Debug().Msg("signinKey not provided in the configuration file")
 		c.SignKey = DEFAULT_SIGN
@@ -69,10 +89,96 @@ func NewConfigFromFile(path string) (*Config, error) {
 		c.AuthKey = DEFAULT_AUTH
 	}
 
+	if c.Logging.Level == "" {
+		c.Logging.Level = zerolog.InfoLevel.String()
+	}
+	if _, err := zerolog.ParseLevel(c.Logging.Level); err != nil {
+		return nil, fmt.Errorf("unknown logging level \"%s\": %w", c.Logging.Level, err)
+	}
+	switch c.Logging.Format {
+	case "":
+		c.Logging.Format = LogFormatConsole
+	case LogFormatConsole, LogFormatJSON:
+	default:
+		return nil, fmt.Errorf("unknown logging format \"%s\", must be either \"%s\" or \"%s\"", c.Logging.Format, LogFormatConsole, LogFormatJSON)
+	}
+	if c.Logging.MaxSizeMB == 0 {
+		c.Logging.MaxSizeMB = 100
+	}
+
+	switch c.ProxyRouting {
+	case "":
+		c.ProxyRouting = ProxyRoutingSubdomain
+	case ProxyRoutingSubdomain, ProxyRoutingPath:
+	default:
+		return nil, fmt.Errorf("unknown proxy-routing \"%s\", must be either \"%s\" or \"%s\"", c.ProxyRouting, ProxyRoutingSubdomain, ProxyRoutingPath)
+	}
+
+	if c.TLS.Enabled {
+		if c.TLS.CertFile == "" || c.TLS.KeyFile == "" {
+			return nil, errors.New("tls is enabled but cert-file or key-file is not provided")
+		}
+		if c.TLS.RequireClientCert && c.TLS.CAFile == "" {
+			return nil, errors.New("require-client-cert is enabled but ca-file is not provided")
+		}
+	}
+
 	if c.MaxWorkers == 0 {
 		c.MaxWorkers = 5
 	}
 
+	if c.TaskRetry.MaxAttempts == 0 {
+		c.TaskRetry.MaxAttempts = 3
+	}
+	if c.TaskRetry.InitialBackoff == 0 {
+		c.TaskRetry.InitialBackoff = 5
+	}
+	if c.TaskRetry.MaxBackoff == 0 {
+		c.TaskRetry.MaxBackoff = 60
+	}
+
+	if c.TaskQueueSize == 0 {
+		c.TaskQueueSize = worker.DefaultQueueSize
+	}
+
+	if c.Monitoring.Interval == 0 {
+		c.Monitoring.Interval = 5
+	}
+	if c.Monitoring.LabUsageInterval == 0 {
+		c.Monitoring.LabUsageInterval = 30
+	}
+	if c.Monitoring.Interval < 0 || c.Monitoring.LabUsageInterval < 0 {
+		return nil, errors.New("monitoring interval and lab-usage-interval must be positive")
+	}
+	if c.Monitoring.Thresholds.CPUPercent == 0 {
+		c.Monitoring.Thresholds.CPUPercent = 90
+	}
+	if c.Monitoring.Thresholds.MemoryPercent == 0 {
+		c.Monitoring.Thresholds.MemoryPercent = 90
+	}
+	if c.Monitoring.Thresholds.DiskPercent == 0 {
+		c.Monitoring.Thresholds.DiskPercent = 90
+	}
+	if c.Monitoring.Thresholds.LoadPerCore == 0 {
+		c.Monitoring.Thresholds.LoadPerCore = 2
+	}
+
+	if c.Tracing.Enabled {
+		switch c.Tracing.Exporter {
+		case "":
+			c.Tracing.Exporter = TracingExporterOTLP
+		case TracingExporterOTLP, TracingExporterFile:
+		default:
+			return nil, fmt.Errorf("unknown tracing exporter \"%s\", must be either \"%s\" or \"%s\"", c.Tracing.Exporter, TracingExporterOTLP, TracingExporterFile)
+		}
+		if c.Tracing.Endpoint == "" {
+			c.Tracing.Endpoint = "localhost:4318"
+		}
+		if c.Tracing.SampleRatio == 0 {
+			c.Tracing.SampleRatio = 1
+		}
+	}
+
 	// In case paths has not been set, use working directory
 	pwd, err := os.Getwd()
 	if err != nil {
@@ -82,6 +188,18 @@ func NewConfigFromFile(path string) (*Config, error) {
 		log.Fatal().Msg("statepath not provided in the configuration file\n Please provide a path for the state file to be saved")
 	}
 
+	if c.AuditLog.Path == "" {
+		c.AuditLog.Path = filepath.Join(c.StatePath, "audit.log")
+	}
+
+	if c.AuditLog.MaxSizeMB == 0 {
+		c.AuditLog.MaxSizeMB = 100
+	}
+
+	if c.Tracing.File == "" {
+		c.Tracing.File = filepath.Join(c.StatePath, "traces.json")
+	}
+
 	if c.FileTransferRoot == "" {
 		log.Debug().Msg("filetransfer root not provided in the configuration file")
 		c.FileTransferRoot = filepath.Join(pwd, "filetransfer")
@@ -99,7 +217,7 @@ func NewConfigFromFile(path string) (*Config, error) {
 	return &c, nil
 }
 
-func New(conf *Config) (*Agent, error) {
+func New(conf *Config, build BuildInfo) (*Agent, error) {
 	// Creating filetransfer root if not exists
 	err := virtual.CreateFileTransferRoot(conf.FileTransferRoot)
 	if err != nil {
@@ -114,10 +232,16 @@ func New(conf *Config) (*Agent, error) {
 		}
 	}
 
+	if conf.Tracing.Enabled {
+		if err := setupTracing(conf.Tracing, build.Version); err != nil {
+			return nil, err
+		}
+	}
+
 	// Creating and starting a workerPool for lab creation
 	// This is to ensure that resources are not spent without having them
 	// Workeramount can be configured from the config
-	workerPool := worker.NewWorkerPool(conf.MaxWorkers)
+	workerPool := worker.NewWorkerPool(conf.MaxWorkers, conf.TaskQueueSize, conf.MaxWorkersPerEnv)
 	workerPool.Run()
 
 	vlib := virtual.NewLibrary(conf.OvaDir)
@@ -140,38 +264,107 @@ func New(conf *Config) (*Agent, error) {
 			ClosingEnvs:  make(map[string]bool),
 		}
 	}
+	var tlsConfig *tls.Config
+	if conf.TLS.Enabled {
+		tlsConfig, err = newTLSConfig(conf.TLS)
+		if err != nil {
+			return nil, fmt.Errorf("error setting up tls: %w", err)
+		}
+	}
+
 	// Creating agent struct
 	a := &Agent{
-		config:     conf,
-		workerPool: workerPool,
-		vlib:       vlib,
-		auth:       NewAuthenticator(conf.SignKey, conf.AuthKey),
-		newLabs:    make(chan pb.Lab, 1000),
-		EnvPool:    envPool,
-		State:      &state.State{},
+		config:      conf,
+		workerPool:  workerPool,
+		vlib:        vlib,
+		auth:        NewAuthenticator(conf.SignKey, conf.AuthKey, conf.SignKeys),
+		newLabs:     newLabFeed(),
+		loginTokens: newLoginTokenStore(),
+		limiter:     newProxyLimiter(conf.RateLimit),
+		tlsConfig:   tlsConfig,
+		auditLog:    newAuditLogger(conf.AuditLog),
+		labUsage:    newLabUsageStore(conf.StatePath),
+		logLevel:    newLogLevelControl(conf.Logging.Level),
+		build:       build,
+		EnvPool:     envPool,
+		State:       &state.State{},
 	}
+
+	// Spectators are only allowed to watch labs for a limited amount of time
+	go a.removeExpiredSpectators(time.Minute)
+	go a.limiter.removeIdleVisitors(time.Minute)
+	go a.sampleLabUsage(time.Duration(conf.Monitoring.LabUsageInterval) * time.Second)
+
 	return a, nil
 }
 
-func (d *Agent) NewGRPCServer(opts ...grpc.ServerOption) *grpc.Server {
+// Adds a task to the worker pool, waiting at most the configured task queue timeout if the queue is full
+func (a *Agent) addTask(ctx context.Context, opts worker.TaskOpts, run func(ctx context.Context) error) (*worker.Task, error) {
+	ctx, cancel := context.WithTimeout(ctx, time.Duration(a.config.TaskQueueTimeout)*time.Second)
+	defer cancel()
+	return a.workerPool.AddTask(ctx, opts, run)
+}
 
-	streamInterceptor := func(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
-		if err := d.auth.AuthenticateContext(stream.Context()); err != nil {
-			return err
-		}
-		return handler(srv, stream)
+// Runs a task in the pool and waits for it to finish, for calls which return the result of the task.
+// The task keeps running if the call is canceled while waiting.
+func (a *Agent) runTask(ctx context.Context, opts worker.TaskOpts, run func(ctx context.Context) error) error {
+	task, err := a.addTask(ctx, opts, run)
+	if err != nil {
+		return err
 	}
+	select {
+	case <-task.Done():
+		return task.Err()
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
 
-	unaryInterceptor := func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
-		if err := d.auth.AuthenticateContext(ctx); err != nil {
-			return nil, err
-		}
-		return handler(ctx, req)
+// Lab creation is retried when it fails with a transient error from docker or VirtualBox
+func (a *Agent) labRetryPolicy() worker.RetryPolicy {
+	return worker.RetryPolicy{
+		MaxAttempts:    a.config.TaskRetry.MaxAttempts,
+		InitialBackoff: time.Duration(a.config.TaskRetry.InitialBackoff) * time.Second,
+		MaxBackoff:     time.Duration(a.config.TaskRetry.MaxBackoff) * time.Second,
+		Retryable:      virtual.IsTransientErr,
 	}
+}
 
+func (d *Agent) NewGRPCServer(opts ...grpc.ServerOption) *grpc.Server {
 	opts = append([]grpc.ServerOption{
-		grpc.StreamInterceptor(streamInterceptor),
-		grpc.UnaryInterceptor(unaryInterceptor),
+		grpc.ChainStreamInterceptor(d.streamInterceptors()...),
+		grpc.ChainUnaryInterceptor(d.unaryInterceptors()...),
 	}, opts...)
 	return grpc.NewServer(opts...)
 }
+
+// Calls are traced from the start and audited before they are authenticated, so calls failing authentication are
+// audited too, with the status code the daemon receives. The same interceptors are used by the HTTP gateway.
+func (d *Agent) unaryInterceptors() []grpc.UnaryServerInterceptor {
+	return []grpc.UnaryServerInterceptor{d.tracingUnaryInterceptor, d.loggingUnaryInterceptor, d.auditUnaryInterceptor, d.authUnaryInterceptor, d.statusUnaryInterceptor}
+}
+
+func (d *Agent) streamInterceptors() []grpc.StreamServerInterceptor {
+	return []grpc.StreamServerInterceptor{d.tracingStreamInterceptor, d.auditStreamInterceptor, d.authStreamInterceptor, d.statusStreamInterceptor}
+}
+
+// The health service is not authenticated so load balancers and orchestrators can probe the agent
+func (d *Agent) authStreamInterceptor(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	if isHealthMethod(info.FullMethod) {
+		return handler(srv, stream)
+	}
+	if err := d.auth.AuthenticateContext(stream.Context(), info.FullMethod); err != nil {
+		return authStatusErr(err)
+	}
+	return handler(srv, stream)
+}
+
+func (d *Agent) authUnaryInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	if isHealthMethod(info.FullMethod) {
+		return handler(ctx, req)
+	}
+	if err := d.auth.AuthenticateContext(ctx, info.FullMethod); err != nil {
+		return nil, authStatusErr(err)
+	}
+	return handler(ctx, req)
+}
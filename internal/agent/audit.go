@@ -0,0 +1,275 @@
+package agent
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aau-network-security/haaukins-agent/pkg/proto"
+	jwt "github.com/golang-jwt/jwt/v4"
+	"github.com/rs/zerolog/log"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+	"gopkg.in/natefinch/lumberjack.v2"
+)
+
+const (
+	defaultAuditQueryLimit = 1000
+	maxAuditQueryLimit     = 10000
+)
+
+// A single call to a mutating RPC, stored as a line of JSON in the audit log
+type auditEntry struct {
+	Time       time.Time `json:"time"`
+	Caller     string    `json:"caller"`
+	Peer       string    `json:"peer,omitempty"`
+	Method     string    `json:"method"`
+	EventTag   string    `json:"eventTag,omitempty"`
+	LabTag     string    `json:"labTag,omitempty"`
+	Result     string    `json:"result"`
+	Error      string    `json:"error,omitempty"`
+	DurationMs int64     `json:"durationMs"`
+}
+
+// Appends audit entries to a JSON lines file which is rotated by size
+type auditLogger struct {
+	m    sync.Mutex
+	path string
+	out  *lumberjack.Logger
+}
+
+func newAuditLogger(conf AuditLogConf) *auditLogger {
+	return &auditLogger{
+		path: conf.Path,
+		out: &lumberjack.Logger{
+			Filename:   conf.Path,
+			MaxSize:    conf.MaxSizeMB,
+			MaxBackups: conf.MaxBackups,
+			MaxAge:     conf.MaxAgeDays,
+		},
+	}
+}
+
+func (al *auditLogger) write(entry auditEntry) {
+	data, err := json.Marshal(entry)
+	if err != nil {
+		log.Error().Err(err).Msg("error marshalling audit entry")
+		return
+	}
+
+	al.m.Lock()
+	defer al.m.Unlock()
+	if _, err := al.out.Write(append(data, '\n')); err != nil {
+		log.Error().Err(err).Str("method", entry.Method).Msg("error writing audit entry")
+	}
+}
+
+// Returns the most recent entries within the time range which concern the tag, oldest first.
+// Rotated files are read as well, so entries are kept as long as the backups are.
+// Files are only opened while holding the lock, so calls are not blocked while the files are scanned.
+// Open files can still be read if they are rotated or removed in the meantime.
+func (al *auditLogger) query(from, to time.Time, tag string, limit int) ([]auditEntry, error) {
+	files, err := al.open()
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		for _, f := range files {
+			f.Close()
+		}
+	}()
+
+	var entries []auditEntry
+	for _, f := range files {
+		scanner := bufio.NewScanner(f)
+		for scanner.Scan() {
+			var entry auditEntry
+			if err := json.Unmarshal(scanner.Bytes(), &entry); err != nil {
+				continue
+			}
+			if !from.IsZero() && entry.Time.Before(from) {
+				continue
+			}
+			if !to.IsZero() && entry.Time.After(to) {
+				continue
+			}
+			if tag != "" && !entry.concerns(tag) {
+				continue
+			}
+			entries = append(entries, entry)
+			if len(entries) > limit {
+				entries = entries[1:]
+			}
+		}
+		if err := scanner.Err(); err != nil {
+			return nil, err
+		}
+	}
+	return entries, nil
+}
+
+// Opens the audit log files, oldest first, while no entries are written or files rotated
+func (al *auditLogger) open() ([]*os.File, error) {
+	al.m.Lock()
+	defer al.m.Unlock()
+
+	var files []*os.File
+	for _, file := range al.files() {
+		f, err := os.Open(file)
+		if err != nil {
+			if errors.Is(err, os.ErrNotExist) {
+				continue
+			}
+			for _, f := range files {
+				f.Close()
+			}
+			return nil, err
+		}
+		files = append(files, f)
+	}
+	return files, nil
+}
+
+// Returns the rotated audit log files followed by the current file, oldest first
+func (al *auditLogger) files() []string {
+	ext := filepath.Ext(al.path)
+	prefix := strings.TrimSuffix(al.path, ext)
+	backups, _ := filepath.Glob(prefix + "-*" + ext)
+	// Backups are named with their rotation time, so sorting by name sorts them by time
+	sort.Strings(backups)
+	return append(backups, al.path)
+}
+
+// Lab tags are prefixed with the event tag, so entries for the labs of an event concern the event as well
+func (e auditEntry) concerns(tag string) bool {
+	return e.EventTag == tag || e.LabTag == tag || strings.HasPrefix(e.LabTag, tag+"-")
+}
+
+// Records every call to an RPC which modifies environments or labs in the audit log
+func (a *Agent) auditUnaryInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	if requiredScope(info.FullMethod) == ScopeRead {
+		return handler(ctx, req)
+	}
+
+	start := time.Now()
+	resp, err := handler(ctx, req)
+	a.audit(ctx, info.FullMethod, req, start, err)
+	return resp, err
+}
+
+func (a *Agent) auditStreamInterceptor(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	if requiredScope(info.FullMethod) == ScopeRead {
+		return handler(srv, stream)
+	}
+
+	start := time.Now()
+	err := handler(srv, stream)
+	a.audit(stream.Context(), info.FullMethod, nil, start, err)
+	return err
+}
+
+func (a *Agent) audit(ctx context.Context, fullMethod string, req interface{}, start time.Time, err error) {
+	entry := auditEntry{
+		Time:       start,
+		Caller:     callerIdentity(ctx, status.Code(err) != codes.Unauthenticated),
+		Method:     fullMethod,
+		Result:     status.Code(err).String(),
+		DurationMs: time.Since(start).Milliseconds(),
+	}
+	if p, ok := peer.FromContext(ctx); ok {
+		entry.Peer = p.Addr.String()
+	}
+	if err != nil {
+		entry.Error = err.Error()
+	}
+
+	if r, ok := req.(interface{ GetEventTag() string }); ok {
+		entry.EventTag = r.GetEventTag()
+	}
+	if r, ok := req.(interface{ GetEnvTag() string }); ok && entry.EventTag == "" {
+		entry.EventTag = r.GetEnvTag()
+	}
+	if r, ok := req.(interface{ GetLabTag() string }); ok {
+		entry.LabTag = r.GetLabTag()
+	}
+	if entry.EventTag == "" && entry.LabTag != "" {
+		entry.EventTag = strings.Split(entry.LabTag, "-")[0]
+	}
+
+	a.auditLog.write(entry)
+}
+
+// Identifies the caller by the subject of its token, or by the client certificate if the token has no subject.
+// Calls which failed authentication are audited too, so the subject is marked as unverified unless the token was verified.
+func callerIdentity(ctx context.Context, verified bool) string {
+	if md, ok := metadata.FromIncomingContext(ctx); ok && len(md["token"]) > 0 {
+		claims := jwt.MapClaims{}
+		if _, _, err := new(jwt.Parser).ParseUnverified(md["token"][0], claims); err == nil {
+			if sub, ok := claims["sub"].(string); ok && sub != "" {
+				if !verified {
+					return "unverified:" + sub
+				}
+				return sub
+			}
+		}
+	}
+
+	if p, ok := peer.FromContext(ctx); ok {
+		if tlsInfo, ok := p.AuthInfo.(credentials.TLSInfo); ok && len(tlsInfo.State.PeerCertificates) > 0 {
+			return "cert:" + tlsInfo.State.PeerCertificates[0].Subject.CommonName
+		}
+	}
+	return "unknown"
+}
+
+// Returns entries from the audit log within a time range, optionally only for a specific event or lab tag
+func (a *Agent) QueryAuditLog(ctx context.Context, req *proto.AuditLogRequest) (*proto.AuditLogResponse, error) {
+	var from, to time.Time
+	if req.From > 0 {
+		from = time.Unix(req.From, 0)
+	}
+	if req.To > 0 {
+		to = time.Unix(req.To, 0)
+	}
+
+	limit := defaultAuditQueryLimit
+	if req.Limit > 0 {
+		limit = int(req.Limit)
+	}
+	if limit > maxAuditQueryLimit {
+		limit = maxAuditQueryLimit
+	}
+
+	entries, err := a.auditLog.query(from, to, req.Tag, limit)
+	if err != nil {
+		log.Error().Err(err).Msg("error querying audit log")
+		return nil, err
+	}
+
+	var protoEntries []*proto.AuditEntry
+	for _, e := range entries {
+		protoEntries = append(protoEntries, &proto.AuditEntry{
+			Time:       e.Time.Unix(),
+			Caller:     e.Caller,
+			Peer:       e.Peer,
+			Method:     e.Method,
+			EventTag:   e.EventTag,
+			LabTag:     e.LabTag,
+			Result:     e.Result,
+			Error:      e.Error,
+			DurationMs: e.DurationMs,
+		})
+	}
+	return &proto.AuditLogResponse{Entries: protoEntries}, nil
+}
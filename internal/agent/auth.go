@@ -4,38 +4,98 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strings"
+	"time"
 
+	"github.com/aau-network-security/haaukins-agent/pkg/proto"
 	jwt "github.com/golang-jwt/jwt/v4"
 	"google.golang.org/grpc/metadata"
 )
 
 const (
-	AUTH_KEY = "au"
+	AUTH_KEY  = "au"
+	SCOPE_KEY = "scope"
+
+	// Allowed clock difference between the daemon and the agent when checking iat
+	clockSkewLeeway = time.Minute
 )
 
 var (
 	InvalidAuthKey        = errors.New("Invalid Authentication Key")
 	InvalidTokenFormatErr = errors.New("Invalid token format")
 	MissingKeyErr         = errors.New("No Authentication Key provided")
+	ExpiredTokenErr       = errors.New("Token is expired or missing exp")
+	InvalidIssuedAtErr    = errors.New("Token is issued in the future or missing iat")
+	UnknownSigningKeyErr  = errors.New("Unknown signing key")
+	InsufficientScopeErr  = errors.New("Token does not have the scope required for this call")
 )
 
+// Scopes which can be granted to a token. A scope includes all scopes below it.
+const (
+	ScopeRead       = "read"
+	ScopeLabsWrite  = "labs:write"
+	ScopeEnvAdmin   = "env:admin"
+	scopeLevelNone  = 0
+	scopeLevelRead  = 1
+	scopeLevelLabs  = 2
+	scopeLevelAdmin = 3
+)
+
+var scopeLevels = map[string]int{
+	ScopeRead:      scopeLevelRead,
+	ScopeLabsWrite: scopeLevelLabs,
+	ScopeEnvAdmin:  scopeLevelAdmin,
+}
+
+// Scope required for each RPC of the agent. RPCs not in the list require env:admin
+var methodScopes = map[string]string{
+	"ListEnvironments":      ScopeRead,
+	"Ping":                  ScopeRead,
+	"MonitorStream":         ScopeRead,
+	"GetLab":                ScopeRead,
+	"GetHostsInLab":         ScopeRead,
+	"ListRecordings":        ScopeRead,
+	"DownloadRecording":     ScopeRead,
+	"GetAgentInfo":          ScopeRead,
+	"ListTasks":             ScopeRead,
+	"GetLabUsage":           ScopeRead,
+	"CreateLabForEnv":       ScopeLabsWrite,
+	"CreateVpnConfForLab":   ScopeLabsWrite,
+	"CloseLab":              ScopeLabsWrite,
+	"AddExercisesToLab":     ScopeLabsWrite,
+	"ResetLab":              ScopeLabsWrite,
+	"ResetExerciseInLab":    ScopeLabsWrite,
+	"StartExerciseInLab":    ScopeLabsWrite,
+	"StopExerciseInLab":     ScopeLabsWrite,
+	"ResetVmInLab":          ScopeLabsWrite,
+	"ConvertRecording":      ScopeLabsWrite,
+	"CreateSpectatorAccess": ScopeLabsWrite,
+	"CreateTerminalAccess":  ScopeLabsWrite,
+	"CreateEnvironment":     ScopeEnvAdmin,
+	"CloseEnvironment":      ScopeEnvAdmin,
+	"AddExercisesToEnv":     ScopeEnvAdmin,
+	"QueryAuditLog":         ScopeEnvAdmin,
+}
+
 type Authenticator interface {
-	AuthenticateContext(context.Context) error
+	AuthenticateContext(ctx context.Context, fullMethod string) error
 }
 
 type auth struct {
-	sKey string // Signin Key
-	aKey string // Auth Key
+	sKey  string            // Signin Key
+	sKeys map[string]string // Additional signin keys by key id, used while rotating keys
+	aKey  string            // Auth Key
 }
 
-func NewAuthenticator(Skey, AKey string) Authenticator {
-	return &auth{sKey: Skey, aKey: AKey}
+func NewAuthenticator(Skey, AKey string, SKeys map[string]string) Authenticator {
+	return &auth{sKey: Skey, aKey: AKey, sKeys: SKeys}
 }
 
 /* Probably from googles grpc docs or something or some article
-Checks incoming token from incoming context to validate whoever is trying to use the agents GRPc calls
+Checks incoming token from incoming context to validate whoever is trying to use the agents GRPc calls.
+The token must not be expired and must have a scope which allows calling the method.
 */
-func (a *auth) AuthenticateContext(ctx context.Context) error {
+func (a *auth) AuthenticateContext(ctx context.Context, fullMethod string) error {
 	md, ok := metadata.FromIncomingContext(ctx)
 	if !ok {
 		return MissingKeyErr
@@ -50,13 +110,9 @@ func (a *auth) AuthenticateContext(ctx context.Context) error {
 		return MissingKeyErr
 	}
 
-	jwtToken, err := jwt.Parse(token, func(token *jwt.Token) (interface{}, error) {
-		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
-			return ctx, fmt.Errorf("Unexpected signing method: %v", token.Header["alg"])
-		}
-
-		return []byte(a.sKey), nil
-	})
+	// Claims are validated below to allow for clock skew
+	parser := &jwt.Parser{SkipClaimsValidation: true}
+	jwtToken, err := parser.Parse(token, a.signingKey)
 	if err != nil {
 		return err
 	}
@@ -66,6 +122,19 @@ func (a *auth) AuthenticateContext(ctx context.Context) error {
 		return InvalidTokenFormatErr
 	}
 
+	// Tokens for the browser terminal only give access to a lab through the proxy
+	if claims.VerifyAudience(terminalAudience, true) {
+		return InvalidTokenFormatErr
+	}
+
+	now := time.Now()
+	if !claims.VerifyExpiresAt(now.Unix(), true) {
+		return ExpiredTokenErr
+	}
+	if !claims.VerifyIssuedAt(now.Add(clockSkewLeeway).Unix(), true) {
+		return InvalidIssuedAtErr
+	}
+
 	authKey, ok := claims[AUTH_KEY].(string)
 	if !ok {
 		return InvalidTokenFormatErr
@@ -75,5 +144,64 @@ func (a *auth) AuthenticateContext(ctx context.Context) error {
 		return InvalidAuthKey
 	}
 
+	scope, _ := claims[SCOPE_KEY].(string)
+	if tokenScopeLevel(scope) < scopeLevels[requiredScope(fullMethod)] {
+		return InsufficientScopeErr
+	}
+
 	return nil
 }
+
+// Returns the key to verify a token with. Tokens with a kid header are verified with the matching key,
+// which allows a new key to be used by the daemon while tokens signed with the old key are still accepted.
+func (a *auth) signingKey(token *jwt.Token) (interface{}, error) {
+	return signingKeyFunc(a.sKey, a.sKeys)(token)
+}
+
+// Returns a function looking up the key of a token in the sign key and the rotated sign keys by kid
+func signingKeyFunc(sKey string, sKeys map[string]string) jwt.Keyfunc {
+	return func(token *jwt.Token) (interface{}, error) {
+		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
+			return nil, fmt.Errorf("Unexpected signing method: %v", token.Header["alg"])
+		}
+
+		kid, ok := token.Header["kid"].(string)
+		if !ok || kid == "" {
+			return []byte(sKey), nil
+		}
+
+		key, ok := sKeys[kid]
+		if !ok {
+			return nil, UnknownSigningKeyErr
+		}
+		return []byte(key), nil
+	}
+}
+
+// Returns the scope required to call a gRPC method like "/agent.Agent/CreateEnvironment".
+// The health and reflection services only read, so they require the read scope.
+func requiredScope(fullMethod string) string {
+	if isHealthMethod(fullMethod) || isReflectionMethod(fullMethod) {
+		return ScopeRead
+	}
+	prefix := "/" + proto.Agent_ServiceDesc.ServiceName + "/"
+	if !strings.HasPrefix(fullMethod, prefix) {
+		return ScopeEnvAdmin
+	}
+	scope, ok := methodScopes[strings.TrimPrefix(fullMethod, prefix)]
+	if !ok {
+		return ScopeEnvAdmin
+	}
+	return scope
+}
+
+// Returns the highest scope level in a space separated list of scopes
+func tokenScopeLevel(scope string) int {
+	level := scopeLevelNone
+	for _, s := range strings.Fields(scope) {
+		if l := scopeLevels[s]; l > level {
+			level = l
+		}
+	}
+	return level
+}
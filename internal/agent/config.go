@@ -8,10 +8,25 @@ type Config struct {
 	Host               string                           `yaml:"host"`
 	GrpcPort           uint                             `yaml:"grpcPort"`
 	ProxyPort          uint                             `yaml:"proxyPort"`
+	MetricsPort        uint                             `yaml:"metricsPort,omitempty"`
+	MetricsListen      string                           `yaml:"metrics-listen,omitempty"` // Metrics are not authenticated, so they are only served locally by default
+	ProxyRouting       string                           `yaml:"proxy-routing,omitempty"`
 	ListeningIp        string                           `yaml:"listening-ip,omitempty"`
+	Logging            LoggingConf                      `yaml:"logging,omitempty"`
+	RateLimit          RateLimitConf                    `yaml:"rate-limit,omitempty"`
+	TLS                TLSConf                          `yaml:"tls,omitempty"`
+	AuditLog           AuditLogConf                     `yaml:"audit-log,omitempty"`
+	Gateway            GatewayConf                      `yaml:"gateway,omitempty"`
+	Tracing            TracingConf                      `yaml:"tracing,omitempty"`
+	Monitoring         MonitoringConf                   `yaml:"monitoring,omitempty"`
 	AuthKey            string                           `yaml:"auth-key"`
 	SignKey            string                           `yaml:"sign-key"`
+	SignKeys           map[string]string                `yaml:"sign-keys,omitempty"`
 	MaxWorkers         int                              `yaml:"max-workers"`
+	MaxWorkersPerEnv   int                              `yaml:"max-workers-per-env,omitempty"`
+	TaskRetry          RetryConf                        `yaml:"task-retry,omitempty"`
+	TaskQueueSize      int                              `yaml:"task-queue-size,omitempty"`
+	TaskQueueTimeout   int                              `yaml:"task-queue-timeout,omitempty"` // Seconds to wait for room in a full task queue
 	FileTransferRoot   string                           `yaml:"file-transfer-root"`
 	OvaDir             string                           `yaml:"ova-dir"`
 	StatePath          string                           `yaml:"state-path"`
@@ -19,6 +34,92 @@ type Config struct {
 	DockerRepositories []dockerclient.AuthConfiguration `yaml:"docker-repositories"`
 }
 
+const (
+	// Environments are found from the subdomain like <eventTag>.<host>/guacamole
+	ProxyRoutingSubdomain = "subdomain"
+	// Environments are found from the path like <host>/events/<eventTag>/guacamole
+	ProxyRoutingPath = "path"
+)
+
+// Log of the agent. Logs are written to stderr unless a file is set, which is rotated when it reaches max-size-mb
+type LoggingConf struct {
+	Level      string `yaml:"level"`
+	Format     string `yaml:"format"`
+	File       string `yaml:"file,omitempty"`
+	MaxSizeMB  int    `yaml:"max-size-mb,omitempty"`
+	MaxBackups int    `yaml:"max-backups,omitempty"`
+	MaxAgeDays int    `yaml:"max-age-days,omitempty"`
+}
+
+// Limits for the proxy. Rates are in requests per second, and a limit of 0 disables it
+type RateLimitConf struct {
+	RequestsPerIP    float64 `yaml:"requests-per-ip"`
+	BurstPerIP       int     `yaml:"burst-per-ip"`
+	RequestsPerEvent float64 `yaml:"requests-per-event"`
+	BurstPerEvent    int     `yaml:"burst-per-event"`
+	TunnelsPerIP     int     `yaml:"tunnels-per-ip"`
+	TunnelsPerEvent  int     `yaml:"tunnels-per-event"`
+}
+
+// Serves gRPC over TLS and the proxy over HTTPS using the same certificate.
+// If a CA is set, client certificates from the daemon are verified against it
+type TLSConf struct {
+	Enabled           bool   `yaml:"enabled"`
+	CertFile          string `yaml:"cert-file"`
+	KeyFile           string `yaml:"key-file"`
+	CAFile            string `yaml:"ca-file,omitempty"`
+	RequireClientCert bool   `yaml:"require-client-cert,omitempty"`
+}
+
+// Audit log of calls to mutating RPCs. The log is rotated when it reaches max-size-mb
+type AuditLogConf struct {
+	Path       string `yaml:"path"`
+	MaxSizeMB  int    `yaml:"max-size-mb"`
+	MaxBackups int    `yaml:"max-backups"`
+	MaxAgeDays int    `yaml:"max-age-days"`
+}
+
+// Retries of lab creation after transient errors. Backoffs are in seconds and double after every attempt
+type RetryConf struct {
+	MaxAttempts    int `yaml:"max-attempts"`
+	InitialBackoff int `yaml:"initial-backoff"`
+	MaxBackoff     int `yaml:"max-backoff"`
+}
+
+// HTTP/JSON gateway for the gRPC API, served with the same TLS config as gRPC
+type GatewayConf struct {
+	Enabled      bool     `yaml:"enabled"`
+	Port         uint     `yaml:"port"`
+	AllowOrigins []string `yaml:"allow-origins,omitempty"` // Origins of browser clients, cross-origin requests are not allowed if empty
+}
+
+// OpenTelemetry tracing. Spans are sent to the OTLP/HTTP endpoint of a collector,
+// or written to a file as JSON
+type TracingConf struct {
+	Enabled     bool    `yaml:"enabled"`
+	Exporter    string  `yaml:"exporter"`
+	Endpoint    string  `yaml:"endpoint,omitempty"`
+	Insecure    bool    `yaml:"insecure,omitempty"`
+	File        string  `yaml:"file,omitempty"`
+	SampleRatio float64 `yaml:"sample-ratio,omitempty"`
+}
+
+// Pushes on the monitoring stream. Intervals are in seconds. Lab usage is sampled less often,
+// since docker takes a second to sample each container
+type MonitoringConf struct {
+	Interval         int           `yaml:"interval"`
+	LabUsageInterval int           `yaml:"lab-usage-interval"`
+	Thresholds       ThresholdConf `yaml:"thresholds"`
+}
+
+// Host resource usage above which warnings are sent on the monitoring stream. Load is per CPU core
+type ThresholdConf struct {
+	CPUPercent    float64 `yaml:"cpu-percent"`
+	MemoryPercent float64 `yaml:"memory-percent"`
+	DiskPercent   float64 `yaml:"disk-percent"`
+	LoadPerCore   float64 `yaml:"load-per-core"`
+}
+
 type VPNconf struct {
 	Endpoint   string `yaml:"endpoint"`
 	Port       uint64 `yaml:"port"`
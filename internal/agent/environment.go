@@ -3,10 +3,10 @@ package agent
 import (
 	"context"
 	"encoding/json"
-	"errors"
 	"fmt"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/aau-network-security/haaukins-agent/internal/environment"
 	env "github.com/aau-network-security/haaukins-agent/internal/environment"
@@ -14,9 +14,15 @@ import (
 	"github.com/aau-network-security/haaukins-agent/internal/environment/lab/exercise"
 	wg "github.com/aau-network-security/haaukins-agent/internal/environment/lab/network/vpn"
 	"github.com/aau-network-security/haaukins-agent/internal/environment/lab/virtual"
+	"github.com/aau-network-security/haaukins-agent/internal/logging"
+	"github.com/aau-network-security/haaukins-agent/internal/metrics"
 	"github.com/aau-network-security/haaukins-agent/internal/state"
+	"github.com/aau-network-security/haaukins-agent/internal/tracing"
+	"github.com/aau-network-security/haaukins-agent/internal/worker"
 	"github.com/aau-network-security/haaukins-agent/pkg/proto"
 	"github.com/rs/zerolog/log"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 var (
@@ -39,7 +45,7 @@ func (a *Agent) CreateEnvironment(ctx context.Context, req *proto.CreatEnvReques
 	log.Debug().Msgf("got createEnv request: %v", req)
 
 	if a.EnvPool.DoesEnvExist(req.EventTag) {
-		return nil, fmt.Errorf("environment with tag: \"%s\" already exists", req.EventTag)
+		return nil, fmt.Errorf("%w with tag: %s", environment.EnvExistsErr, req.EventTag)
 	}
 
 	// Create a new environment for event if it does not exists
@@ -49,6 +55,23 @@ func (a *Agent) CreateEnvironment(ctx context.Context, req *proto.CreatEnvReques
 	envConf.Type = lab.LabType(req.EnvType)
 	envConf.WorkerPool = a.workerPool
 	envConf.TeamSize = int(req.TeamSize)
+	envConf.RecordSessions = req.RecordSessions
+	if req.DesktopPolicy != nil {
+		envConf.DesktopPolicy = virtual.DesktopPolicy{
+			DisableCopy:      req.DesktopPolicy.DisableCopy,
+			DisablePaste:     req.DesktopPolicy.DisablePaste,
+			DisableDrive:     req.DesktopPolicy.DisableDrive,
+			DisableWallpaper: req.DesktopPolicy.DisableWallpaper,
+			DisableAudio:     req.DesktopPolicy.DisableAudio,
+			Width:            uint(req.DesktopPolicy.Width),
+			Height:           uint(req.DesktopPolicy.Height),
+			ColorDepth:       uint(req.DesktopPolicy.ColorDepth),
+		}
+		if err := envConf.DesktopPolicy.Validate(); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid desktop policy: %v", err)
+		}
+	}
+	envConf.LabConf.DesktopPolicy = envConf.DesktopPolicy
 	log.Debug().Str("envtype", envConf.Type.String()).Msg("making environment with type")
 
 	// Unpack into exercise slice
@@ -71,7 +94,7 @@ func (a *Agent) CreateEnvironment(ctx context.Context, req *proto.CreatEnvReques
 	}
 
 	if req.TeamSize == 0 {
-		return nil, errors.New("cannot create env with 0 teamsize")
+		return nil, status.Error(codes.InvalidArgument, "cannot create env with 0 teamsize")
 	}
 	for i := 0; i < int(req.TeamSize); i++ {
 		envConf.LabConf.Frontends = append(envConf.LabConf.Frontends, frontend)
@@ -106,86 +129,115 @@ func (a *Agent) CreateEnvironment(ctx context.Context, req *proto.CreatEnvReques
 	}
 
 	m := &sync.RWMutex{}
-	// If it is a beginner event, labs will be created and be available beforehand
-	if envConf.Type == lab.TypeBeginner {
-		for i := 0; i < int(req.InitialLabs); i++ {
-			// Adding lab creation task to taskqueue
-			envConf.WorkerPool.AddTask(func() {
-				ctx := context.Background()
-				log.Debug().Uint8("envStatus", uint8(envConf.Status)).Msg("environment status when starting worker")
-				// Make sure that environment is still running before creating lab
-				if envConf.Status == environment.StatusClosing || envConf.Status == environment.StatusClosed {
-					log.Info().Msg("environment closed before newlab task was taken from queue, canceling...")
-					return
-				}
-				// Creating containers and frontends
-				lab, err := envConf.LabConf.NewLab(ctx, false, lab.TypeBeginner, envConf.Tag)
-				if err != nil {
-					log.Error().Err(err).Str("eventTag", env.EnvConfig.Tag).Msg("error creating new lab")
-					return
-				}
-				// Starting the created containers and frontends
-				if err := lab.Start(ctx); err != nil {
-					log.Error().Err(err).Str("eventTag", env.EnvConfig.Tag).Msg("error starting new lab")
-					return
-				}
-
-				if err := env.CreateGuacConn(lab); err != nil {
-					log.Error().Err(err).Str("labTag", lab.Tag).Msg("error creating guac connection for lab")
-				}
-
-				log.Debug().Uint8("envStatus", uint8(envConf.Status)).Msg("environment status when ending worker")
-				// If lab was created while running CloseEnvironment, close the lab
-				if envConf.Status == environment.StatusClosing || envConf.Status == environment.StatusClosed {
-					log.Info().Msg("environment closed while newlab task was running from queue, closing lab...")
-					if err := lab.Close(); err != nil {
-						log.Error().Err(err).Msg("error closing lab")
-						return
-					}
-					return
-				}
-				// Sending lab info to daemon
-				// TODO Figure out what exact data should be returned to daemon
-				// TODO use new getChallenges function to get challenges for lab to return flag etc.
-
-				newLab := proto.Lab{
-					Tag:       lab.Tag,
-					EventTag:  envConf.Tag,
-					Exercises: lab.GetExercisesInfo(),
-					IsVPN:     false,
-					GuacCreds: &proto.GuacCreds{
-						Username: lab.GuacUsername,
-						Password: lab.GuacPassword,
-					},
-				}
-				//a.newLabs = append(a.newLabs, newLab)
-				a.newLabs <- newLab
-				// Adding lab to environment
-				m.Lock()
-				env.Labs[lab.Tag] = &lab
-				m.Unlock()
-				// Should not be removed as it runs in a worker
-				if err := state.SaveState(a.EnvPool, a.config.StatePath); err != nil {
-					log.Error().Err(err).Msg("error saving state")
-				}
-			})
+	// Creates an initial lab of a beginner event
+	createInitialLab := func(ctx context.Context) error {
+		start := time.Now()
+		logging.Ctx(ctx).Debug().Uint8("envStatus", uint8(envConf.Status)).Msg("environment status when starting worker")
+		// Make sure that environment is still running before creating lab
+		if envConf.Status == environment.StatusClosing || envConf.Status == environment.StatusClosed {
+			logging.Ctx(ctx).Info().Msg("environment closed before newlab task was taken from queue, canceling...")
+			return context.Canceled
 		}
+		// Creating containers and frontends
+		lab, err := envConf.LabConf.NewLab(ctx, false, lab.TypeBeginner, envConf.Tag)
+		if err != nil {
+			logging.Ctx(ctx).Error().Err(err).Msg("error creating new lab")
+			return err
+		}
+		ctx = logging.With(ctx, logging.LabTag, lab.Tag)
+		// Starting the created containers and frontends
+		stageStart := time.Now()
+		if err := lab.Start(ctx); err != nil {
+			logging.Ctx(ctx).Error().Err(err).Msg("error starting new lab")
+			closeFailedLab(ctx, &lab)
+			return err
+		}
+		metrics.ObserveLabStage(envConf.Tag, metrics.StageStart, stageStart)
+
+		stageStart = time.Now()
+		if err := env.CreateGuacConn(ctx, &lab); err != nil {
+			logging.Ctx(ctx).Error().Err(err).Msg("error creating guac connection for lab")
+		}
+		metrics.ObserveLabStage(envConf.Tag, metrics.StageGuac, stageStart)
+
+		logging.Ctx(ctx).Debug().Uint8("envStatus", uint8(envConf.Status)).Msg("environment status when ending worker")
+		// If lab was created while running CloseEnvironment, close the lab
+		if envConf.Status == environment.StatusClosing || envConf.Status == environment.StatusClosed || ctx.Err() != nil {
+			logging.Ctx(ctx).Info().Msg("environment closed while newlab task was running from queue, closing lab...")
+			if err := lab.Close(ctx); err != nil {
+				logging.Ctx(ctx).Error().Err(err).Msg("error closing lab")
+				return err
+			}
+			return context.Canceled
+		}
+		// Sending lab info to daemon
+		// TODO Figure out what exact data should be returned to daemon
+		// TODO use new getChallenges function to get challenges for lab to return flag etc.
+
+		newLab := &proto.Lab{
+			Tag:       lab.Tag,
+			EventTag:  envConf.Tag,
+			Exercises: lab.GetExercisesInfo(),
+			IsVPN:     false,
+			GuacCreds: &proto.GuacCreds{
+				Username: lab.GuacUsername,
+				Password: lab.GuacPassword,
+			},
+			MemberCreds:     protoMemberCreds(&lab),
+			ExposedServices: a.protoExposedServices(&lab, envConf.Tag),
+			Attempts:        int32(worker.Attempt(ctx)),
+		}
+		metrics.ObserveLabStage(envConf.Tag, metrics.StageTotal, start)
+		//a.newLabs = append(a.newLabs, newLab)
+		a.newLabs.publish(newLab)
+		// Adding lab to environment
+		m.Lock()
+		env.Labs[lab.Tag] = &lab
+		m.Unlock()
+		// Should not be removed as it runs in a worker
+		if err := state.SaveState(a.EnvPool, a.config.StatePath); err != nil {
+			logging.Ctx(ctx).Error().Err(err).Msg("error saving state")
+		}
+		return nil
 	}
 
 	// Start the environment
 	if err := env.Start(context.TODO()); err != nil {
 		log.Error().Err(err).Msg("error creating environment")
+		envConf.Status = environment.StatusClosing
+		a.workerPool.CancelEnvTasks(req.EventTag)
 		vpnIPPool.ReleaseIP(vpnIP)
-		if err := env.Close(); err != nil {
+		if err := env.Close(ctx); err != nil {
 			log.Error().Err(err).Msg("error closing environment after error creating it")
 		}
 		return &proto.StatusResponse{Message: "Error creating environment"}, err
 	}
 
 	a.EnvPool.AddEnv(env)
+	// If it is a beginner event, labs will be created and be available beforehand
+	if envConf.Type == lab.TypeBeginner {
+		go a.queueInitialLabs(logging.Detach(ctx, tracing.Detach(ctx)), &envConf, int(req.InitialLabs), createInitialLab)
+	}
 	return &proto.StatusResponse{Message: "recieved createLabs request... starting labs"}, nil
 }
 
+// Queues the initial labs of a started environment. An event can have more initial labs than fit in the task queue,
+// so they are queued in the background, waiting for room without the timeout calls have.
+// Queueing stops if the environment is closed, and queued labs are canceled when it closes.
+func (a *Agent) queueInitialLabs(ctx context.Context, envConf *env.EnvConfig, count int, create func(ctx context.Context) error) {
+	opts := worker.TaskOpts{Name: "create initial lab", EnvTag: envConf.Tag, Priority: worker.PriorityBulk, Retry: a.labRetryPolicy()}
+	for i := 0; i < count; i++ {
+		if envConf.Status == environment.StatusClosing || envConf.Status == environment.StatusClosed {
+			logging.Ctx(ctx).Info().Int("queuedLabs", i).Int("initialLabs", count).Msg("environment closed while queueing initial labs")
+			return
+		}
+		if _, err := a.workerPool.AddTask(ctx, opts, create); err != nil {
+			logging.Ctx(ctx).Error().Err(err).Int("queuedLabs", i).Int("initialLabs", count).Msg("error queueing initial labs")
+			return
+		}
+	}
+}
+
 // Closes environment and attached containers/vms, and removes the environment from the event pool
 func (a *Agent) CloseEnvironment(ctx context.Context, req *proto.CloseEnvRequest) (*proto.StatusResponse, error) {
 	a.EnvPool.AddClosingEnv(req.EventTag)
@@ -199,28 +251,38 @@ func (a *Agent) CloseEnvironment(ctx context.Context, req *proto.CloseEnvRequest
 	env, err := a.EnvPool.GetEnv(req.EventTag)
 	if err != nil {
 		log.Error().Str("envTag", req.EventTag).Msg("error finding finding environment with tag")
-		return nil, fmt.Errorf("error finding environment with tag: %s", req.EventTag)
+		return nil, fmt.Errorf("%w with tag: %s", environment.EnvNotFoundErr, req.EventTag)
 	}
 
 	env.EnvConfig.Status = environment.StatusClosing
+	// Labs which are queued or being created for the environment are not needed anymore
+	if canceled := a.workerPool.CancelEnvTasks(req.EventTag); canceled > 0 {
+		log.Info().Str("eventTag", req.EventTag).Int("tasks", canceled).Msg("canceled tasks for closing environment")
+	}
 
 	envConf := env.EnvConfig
 
 	vpnIP := strings.ReplaceAll(envConf.VPNAddress, ".240.1/22", "")
 	vpnIPPool.ReleaseIP(vpnIP)
 
-	if err := virtual.RemoveEventFolder(string(envConf.Tag)); err != nil {
+	// Session recordings are kept so they can be reviewed after the event
+	if envConf.RecordSessions {
+		if err := virtual.RemoveEventFolderExcept(envConf.Tag, environment.RecordingsFolder); err != nil {
+			log.Warn().Err(err).Msg("error removing event folder")
+		}
+	} else if err := virtual.RemoveEventFolder(string(envConf.Tag)); err != nil {
 		log.Warn().Err(err).Msg("error removing event folder")
 	}
 
-	if err := env.Close(); err != nil {
+	if err := env.Close(ctx); err != nil {
 		log.Error().Err(err).Msg("error closing environment")
-		return nil, fmt.Errorf("error closing environment %v", err)
+		return nil, fmt.Errorf("error closing environment %w", err)
 	}
 
 	env.EnvConfig.Status = environment.StatusClosed
 
 	a.EnvPool.RemoveEnv(envConf.Tag)
+	metrics.DeleteEvent(envConf.Tag)
 	return &proto.StatusResponse{Message: "OK"}, nil
 }
 
@@ -232,16 +294,14 @@ func (a *Agent) AddExercisesToEnv(ctx context.Context, req *proto.ExerciseReques
 	env, ok := a.EnvPool.Envs[req.EnvTag]
 	if !ok {
 		log.Error().Str("envTag", req.EnvTag).Msg("error finding finding environment with tag")
-		return nil, fmt.Errorf("error finding environment with tag: %s", req.EnvTag)
+		return nil, fmt.Errorf("%w with tag: %s", environment.EnvNotFoundErr, req.EnvTag)
 	}
 
 	if env.EnvConfig.Type == lab.TypeAdvanced {
-		return nil, errors.New("you cannot add exercises to advanced typed environments... use AddExercisesToLab as users manage their own exercises")
+		return nil, fmt.Errorf("%w: you cannot add exercises to advanced typed environments... use AddExercisesToLab as users manage their own exercises", lab.InvalidLabTypeErr)
 	}
 
-	env.M.Lock()
 	defer func() {
-		env.M.Unlock()
 		if err := state.SaveState(a.EnvPool, a.config.StatePath); err != nil {
 			log.Error().Err(err).Msg("error saving state")
 		}
@@ -257,30 +317,52 @@ func (a *Agent) AddExercisesToEnv(ctx context.Context, req *proto.ExerciseReques
 		json.Unmarshal([]byte(ex), &estruct)
 		exerConfs = append(exerConfs, estruct)
 	}
+
+	// The environment is only locked while adding the configs, so other calls for it are not blocked
+	// while the exercises are started in the labs
+	env.M.Lock()
 	for _, eConf := range env.EnvConfig.LabConf.ExerciseConfs {
 		for _, reqConf := range exerConfs {
 			if eConf.Tag == reqConf.Tag {
-				return nil, fmt.Errorf("exercise already exists in environment: %s", reqConf.Tag)
+				env.M.Unlock()
+				return nil, fmt.Errorf("%w in environment: %s", exercise.DuplicateTagErr, reqConf.Tag)
 			}
 		}
 	}
 	env.EnvConfig.LabConf.ExerciseConfs = append(env.EnvConfig.LabConf.ExerciseConfs, exerConfs...)
-
-	// TODO: Is it a problem to use the workerpool here? Maybe just use a go routine for each lab.
-	var wg sync.WaitGroup
-	ctx = context.Background()
-	for k := range env.Labs {
-		wg.Add(1)
-		l := env.Labs[k]
-		a.workerPool.AddTask(func() {
+	labs := make([]*lab.Lab, 0, len(env.Labs))
+	for _, l := range env.Labs {
+		labs = append(labs, l)
+	}
+	env.M.Unlock()
+
+	// Teams are waiting for the exercises, so they are not queued behind the creation of initial labs
+	var tasks []*worker.Task
+	var queueErr error
+	for _, l := range labs {
+		opts := worker.TaskOpts{Name: "add exercises", EnvTag: env.EnvConfig.Tag, LabTag: l.Tag, Priority: worker.PriorityInteractive}
+		task, err := a.addTask(ctx, opts, func(ctx context.Context) error {
 			log.Debug().Str("labTag", l.Tag).Msg("adding exercises for lab")
 			if err := l.AddAndStartExercises(ctx, exerConfs...); err != nil {
 				log.Error().Str("labTag", l.Tag).Err(err).Msg("error adding and starting exercises for lab")
+				return err
 			}
-			wg.Done()
+			return nil
 		})
+		if err != nil {
+			log.Warn().Err(err).Str("labTag", l.Tag).Msg("error queueing exercises for lab")
+			queueErr = err
+			continue
+		}
+		tasks = append(tasks, task)
+	}
+	// Tasks are done when they have run or have been canceled by closing the environment
+	for _, task := range tasks {
+		<-task.Done()
+	}
+	if queueErr != nil {
+		return nil, queueErr
 	}
-	wg.Wait()
 	return &proto.StatusResponse{Message: "OK"}, nil
 }
 
@@ -0,0 +1,160 @@
+package agent
+
+import (
+	"context"
+	"errors"
+	"os"
+
+	"github.com/aau-network-security/haaukins-agent/internal/environment"
+	"github.com/aau-network-security/haaukins-agent/internal/environment/lab"
+	"github.com/aau-network-security/haaukins-agent/internal/environment/lab/exercise"
+	wg "github.com/aau-network-security/haaukins-agent/internal/environment/lab/network/vpn"
+	"github.com/aau-network-security/haaukins-agent/internal/environment/lab/virtual"
+	"github.com/aau-network-security/haaukins-agent/internal/worker"
+	"github.com/rs/zerolog/log"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	resourceEnvironment = "environment"
+	resourceLab         = "lab"
+	resourceExercise    = "exercise"
+)
+
+// Maps errors from the agent to a gRPC code and the type of resource the error concerns
+var errorCodes = []struct {
+	err      error
+	code     codes.Code
+	resource string
+}{
+	{environment.EnvNotFoundErr, codes.NotFound, resourceEnvironment},
+	{environment.EnvExistsErr, codes.AlreadyExists, resourceEnvironment},
+	{environment.LabNotFoundErr, codes.NotFound, resourceLab},
+	{environment.ServiceNotFoundErr, codes.NotFound, resourceLab},
+	{environment.NoFrontendsErr, codes.FailedPrecondition, resourceLab},
+	{environment.InvalidRecordingErr, codes.InvalidArgument, resourceLab},
+	{environment.GuacencNotAvailableErr, codes.FailedPrecondition, ""},
+	{lab.NoTerminalContainerErr, codes.FailedPrecondition, resourceLab},
+	{lab.VmNotFoundErr, codes.NotFound, resourceLab},
+	{lab.MemberNotFoundErr, codes.NotFound, resourceLab},
+	{lab.MemberRequiredErr, codes.InvalidArgument, resourceLab},
+	{lab.NotVPNLabErr, codes.FailedPrecondition, resourceLab},
+	{lab.VPNLabErr, codes.FailedPrecondition, resourceLab},
+	{lab.VPNConfsExistErr, codes.AlreadyExists, resourceLab},
+	{lab.InvalidLabTypeErr, codes.FailedPrecondition, resourceLab},
+	{exercise.UnknownTagErr, codes.NotFound, resourceExercise},
+	{exercise.DuplicateTagErr, codes.AlreadyExists, resourceExercise},
+	{exercise.MissingTagsErr, codes.InvalidArgument, resourceExercise},
+	{NoVPNIPsErr, codes.ResourceExhausted, ""},
+	{worker.QueueFullErr, codes.ResourceExhausted, ""},
+	{virtual.NoAvailableIPsErr, codes.ResourceExhausted, ""},
+	{wg.UnreachableVPNServiceErr, codes.Unavailable, ""},
+	{os.ErrNotExist, codes.NotFound, ""},
+}
+
+// Converts errors returned by the handlers into gRPC status errors, so the daemon can act on the code
+// instead of matching on the error message
+func (a *Agent) statusUnaryInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	resp, err := handler(ctx, req)
+	if err != nil {
+		return resp, toStatusErr(err, req)
+	}
+	return resp, nil
+}
+
+func (a *Agent) statusStreamInterceptor(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	if err := handler(srv, stream); err != nil {
+		return toStatusErr(err, nil)
+	}
+	return nil
+}
+
+// Returns the error as a gRPC status with the code of the error. If the error concerns an environment, lab or exercise,
+// the tags from the request are attached as ResourceInfo details.
+func toStatusErr(err error, req interface{}) error {
+	if _, ok := status.FromError(err); ok {
+		return err
+	}
+
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return status.FromContextError(err).Err()
+	}
+
+	code := codes.Unknown
+	resource := ""
+	for _, e := range errorCodes {
+		if errors.Is(err, e.err) {
+			code = e.code
+			resource = e.resource
+			break
+		}
+	}
+
+	st := status.New(code, err.Error())
+	var details []*errdetails.ResourceInfo
+	for _, tag := range resourceTags(resource, req) {
+		details = append(details, &errdetails.ResourceInfo{
+			ResourceType: resource,
+			ResourceName: tag,
+			Description:  err.Error(),
+		})
+	}
+	if len(details) == 0 {
+		return st.Err()
+	}
+
+	for _, d := range details {
+		withDetails, detailErr := st.WithDetails(d)
+		if detailErr != nil {
+			log.Warn().Err(detailErr).Msg("error attaching details to status")
+			return st.Err()
+		}
+		st = withDetails
+	}
+	return st.Err()
+}
+
+// Returns the tags in the request of the given resource type
+func resourceTags(resource string, req interface{}) []string {
+	var tags []string
+	add := func(tag string) {
+		if tag != "" {
+			tags = append(tags, tag)
+		}
+	}
+
+	switch resource {
+	case resourceEnvironment:
+		if r, ok := req.(interface{ GetEventTag() string }); ok {
+			add(r.GetEventTag())
+		}
+		if r, ok := req.(interface{ GetEnvTag() string }); ok {
+			add(r.GetEnvTag())
+		}
+	case resourceLab:
+		if r, ok := req.(interface{ GetLabTag() string }); ok {
+			add(r.GetLabTag())
+		}
+	case resourceExercise:
+		if r, ok := req.(interface{ GetExercise() string }); ok {
+			add(r.GetExercise())
+		}
+		if r, ok := req.(interface{ GetExercises() []string }); ok {
+			for _, tag := range r.GetExercises() {
+				add(tag)
+			}
+		}
+	}
+	return tags
+}
+
+// Returns the gRPC status for errors from authenticating a call
+func authStatusErr(err error) error {
+	if errors.Is(err, InsufficientScopeErr) {
+		return status.Error(codes.PermissionDenied, err.Error())
+	}
+	return status.Error(codes.Unauthenticated, err.Error())
+}
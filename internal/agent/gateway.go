@@ -0,0 +1,209 @@
+package agent
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"net"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/aau-network-security/haaukins-agent/pkg/proto"
+	"github.com/gin-contrib/cors"
+	"github.com/gin-gonic/gin"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/rs/zerolog/log"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+const (
+	// Buffer of the in-memory connection between the gateway and its gRPC server
+	gatewayBufferSize = 1 << 20
+
+	// Metadata set by the gateway with the address and client certificate of the HTTP client
+	gatewayForwardedForKey = "x-forwarded-for"
+	gatewayClientCertKey   = "x-gateway-client-cert-bin"
+
+	mimeEventStream = "text/event-stream"
+)
+
+// Trace context headers passed on to the gRPC server. No other headers are passed on,
+// so the token can only be given in the Authorization header.
+var gatewayTraceHeaders = map[string]bool{
+	"Traceparent": true,
+	"Tracestate":  true,
+	"Baggage":     true,
+}
+
+var gatewayJSON = runtime.JSONPb{
+	MarshalOptions:   protojson.MarshalOptions{EmitUnpopulated: true},
+	UnmarshalOptions: protojson.UnmarshalOptions{DiscardUnknown: true},
+}
+
+// Runs the HTTP/JSON gateway generated from agent.proto by grpc-gateway on the gateway port.
+// The HTTP rule of each RPC is in pkg/proto/agent_gateway.yaml: RPCs which only read are called with GET
+// and the request as query parameters, all others with POST and the request as a JSON body.
+// Calls are forwarded to a gRPC server of the agent listening in memory, so they go through the same
+// interceptors as gRPC calls, are authenticated with the bearer token in the Authorization header and audited.
+// Streams are sent as server-sent events if the client accepts text/event-stream, otherwise as JSON lines.
+func (a *Agent) RunGateway() error {
+	lis := bufconn.Listen(gatewayBufferSize)
+	server := grpc.NewServer(
+		grpc.ChainStreamInterceptor(append([]grpc.StreamServerInterceptor{gatewayPeerStreamInterceptor}, a.streamInterceptors()...)...),
+		grpc.ChainUnaryInterceptor(append([]grpc.UnaryServerInterceptor{gatewayPeerUnaryInterceptor}, a.unaryInterceptors()...)...),
+	)
+	proto.RegisterAgentServer(server, a)
+	go func() {
+		if err := server.Serve(lis); err != nil {
+			log.Error().Err(err).Msg("error serving gRPC for the http gateway")
+		}
+	}()
+
+	conn, err := grpc.NewClient("passthrough:///gateway",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return lis.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	if err != nil {
+		return err
+	}
+
+	mux := runtime.NewServeMux(
+		runtime.WithMarshalerOption(runtime.MIMEWildcard, &gatewayJSON),
+		runtime.WithMarshalerOption(mimeEventStream, &eventStreamMarshaler{JSONPb: gatewayJSON}),
+		runtime.WithIncomingHeaderMatcher(gatewayHeaderMatcher),
+		runtime.WithMetadata(gatewayMetadata),
+		runtime.WithRoutingErrorHandler(gatewayRoutingErrorHandler),
+	)
+	if err := proto.RegisterAgentHandler(context.Background(), mux, conn); err != nil {
+		return err
+	}
+
+	// Calls are audited, so requests are not logged by gin
+	r := gin.New()
+	r.Use(gin.Recovery())
+	if len(a.config.Gateway.AllowOrigins) > 0 {
+		r.Use(cors.New(cors.Config{
+			AllowOrigins:  a.config.Gateway.AllowOrigins,
+			AllowMethods:  []string{"POST", "GET", "OPTIONS"},
+			AllowHeaders:  []string{"Content-Type", "Content-Length", "Authorization", "Accept", "Origin", "Cache-Control", "traceparent", "tracestate", "baggage"},
+			ExposeHeaders: []string{"Content-Length"},
+			MaxAge:        12 * time.Hour,
+		}))
+	}
+	r.Any("/v1/*method", gin.WrapH(mux))
+
+	listenAddress := fmt.Sprintf("%s:%d", a.config.ListeningIp, a.config.Gateway.Port)
+	log.Info().Str("address", listenAddress).Strs("allowOrigins", a.config.Gateway.AllowOrigins).Msg("running http gateway")
+	if tlsConfig := a.TLSConfig(); tlsConfig != nil {
+		server := &http.Server{
+			Addr:      listenAddress,
+			Handler:   r,
+			TLSConfig: tlsConfig,
+		}
+		return server.ListenAndServeTLS("", "")
+	}
+	return r.Run(listenAddress)
+}
+
+// Writes messages as server-sent events, one event for each message of a stream
+type eventStreamMarshaler struct {
+	runtime.JSONPb
+}
+
+func (m *eventStreamMarshaler) ContentType(interface{}) string {
+	return mimeEventStream
+}
+
+func (m *eventStreamMarshaler) Marshal(v interface{}) ([]byte, error) {
+	data, err := m.JSONPb.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	return append([]byte("data: "), data...), nil
+}
+
+func (m *eventStreamMarshaler) Delimiter() []byte {
+	return []byte("\n\n")
+}
+
+// Calls with the wrong HTTP method, like GET for an RPC which changes anything, are answered with
+// 405 Method Not Allowed instead of 501 Not Implemented like other unimplemented calls
+func gatewayRoutingErrorHandler(ctx context.Context, mux *runtime.ServeMux, m runtime.Marshaler, w http.ResponseWriter, r *http.Request, httpStatus int) {
+	if httpStatus == http.StatusMethodNotAllowed {
+		err := status.Error(codes.Unimplemented, http.StatusText(httpStatus))
+		runtime.HTTPError(ctx, mux, m, w, r, &runtime.HTTPStatusError{HTTPStatus: httpStatus, Err: err})
+		return
+	}
+	runtime.DefaultRoutingErrorHandler(ctx, mux, m, w, r, httpStatus)
+}
+
+func gatewayHeaderMatcher(key string) (string, bool) {
+	if gatewayTraceHeaders[key] {
+		return strings.ToLower(key), true
+	}
+	return "", false
+}
+
+// Returns the token from the Authorization header and the client certificate of the HTTP client as metadata
+func gatewayMetadata(ctx context.Context, r *http.Request) metadata.MD {
+	md := metadata.MD{}
+	if auth := r.Header.Get("Authorization"); strings.HasPrefix(auth, "Bearer ") {
+		md.Set("token", strings.TrimPrefix(auth, "Bearer "))
+	}
+	if r.TLS != nil && len(r.TLS.PeerCertificates) > 0 {
+		md.Set(gatewayClientCertKey, string(r.TLS.PeerCertificates[0].Raw))
+	}
+	return md
+}
+
+// The gRPC server of the gateway is only reachable by the gateway, so the peer of its calls is set to the
+// HTTP client from the metadata of the gateway. Calls are then audited with the address and certificate of the client.
+func gatewayPeerUnaryInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	return handler(gatewayPeerContext(ctx), req)
+}
+
+func gatewayPeerStreamInterceptor(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	return handler(srv, &contextStream{ServerStream: stream, ctx: gatewayPeerContext(stream.Context())})
+}
+
+func gatewayPeerContext(ctx context.Context) context.Context {
+	md, _ := metadata.FromIncomingContext(ctx)
+	p := &peer.Peer{Addr: httpAddr("unknown")}
+	// The gateway appends the address of the client to any X-Forwarded-For header the client sent
+	if xff := md.Get(gatewayForwardedForKey); len(xff) > 0 {
+		addrs := strings.Split(xff[len(xff)-1], ",")
+		p.Addr = httpAddr(strings.TrimSpace(addrs[len(addrs)-1]))
+	}
+	if certs := md.Get(gatewayClientCertKey); len(certs) > 0 {
+		if cert, err := x509.ParseCertificate([]byte(certs[0])); err == nil {
+			p.AuthInfo = credentials.TLSInfo{State: tls.ConnectionState{PeerCertificates: []*x509.Certificate{cert}}}
+		}
+	}
+	return peer.NewContext(context.WithValue(ctx, gatewayKey{}, true), p)
+}
+
+type gatewayKey struct{}
+
+// Returns if the call came through the http gateway
+func fromGateway(ctx context.Context) bool {
+	gateway, _ := ctx.Value(gatewayKey{}).(bool)
+	return gateway
+}
+
+// Address of an HTTP client, used as the peer of gateway calls
+type httpAddr string
+
+func (a httpAddr) Network() string { return "tcp" }
+func (a httpAddr) String() string  { return string(a) }
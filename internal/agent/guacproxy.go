@@ -30,23 +30,48 @@ func (a *Agent) RunGuacProxy() error {
 		MaxAge:           12 * time.Hour,
 	}))
 	//r.Use(a.authMiddleware())
+	r.Use(a.rateLimit)
+	r.Use(a.exposedServiceProxy)
 
 	// Load html template for guaclogin page
 	r.Static("/assets", "./assets")
 	r.LoadHTMLGlob("templates/*.html")
 
-	r.Any("/guacamole/*proxyPath", a.proxy)
-	r.GET("/guaclogin", a.guaclogin)
+	switch a.config.ProxyRouting {
+	case ProxyRoutingPath:
+		// Allows the agent to sit behind a single hostname and a plain certificate
+		events := r.Group("/events/:eventTag")
+		events.Any("/guacamole/*proxyPath", a.proxy)
+		events.GET("/guaclogin", a.guaclogin)
+		events.GET("/terminal", a.terminal)
+		events.GET("/terminal/ws", a.terminalWebsocket)
+	default:
+		r.Any("/guacamole/*proxyPath", a.proxy)
+		r.GET("/guaclogin", a.guaclogin)
+		r.GET("/terminal", a.terminal)
+		r.GET("/terminal/ws", a.terminalWebsocket)
+	}
 
 	listenAddress := fmt.Sprintf("%s:%d", a.config.ListeningIp, a.config.ProxyPort)
+	if tlsConfig := a.proxyTLSConfig(); tlsConfig != nil {
+		server := &http.Server{
+			Addr:      listenAddress,
+			Handler:   r,
+			TLSConfig: tlsConfig,
+		}
+		// Certificate is provided by the TLS config so it can be reloaded
+		return server.ListenAndServeTLS("", "")
+	}
 	return r.Run(listenAddress)
 }
 
 // The guacamole proxy handler uses the subdomain of a request like "http://test.localhost:<proxyPort>/guacamole", to guide a participant to the right guacamole
 // container linked to their event. The subdomain should be the same as the event tag. It will then correlate the event tag to any running environments with the same tag
 // and proxy the request the the corresponding guacamole docker container.
+// If path routing is configured, the event tag is instead taken from the path like "http://localhost:<proxyPort>/events/test/guacamole",
+// and the path, cookies and redirects are rewritten between the event path and the /guacamole path of the container.
 func (a *Agent) proxy(c *gin.Context) {
-	envTag := strings.Split(c.Request.Host, ".")[0]
+	envTag := a.envTagFromRequest(c)
 
 	env, ok := a.EnvPool.Envs[envTag]
 	if !ok {
@@ -71,13 +96,27 @@ func (a *Agent) proxy(c *gin.Context) {
 		req.URL.Host = guacUrl.Host
 	}
 
+	if a.config.ProxyRouting == ProxyRoutingPath {
+		prefix := eventPathPrefix(envTag)
+		director := proxy.Director
+		proxy.Director = func(req *http.Request) {
+			director(req)
+			req.URL.Path = strings.TrimPrefix(req.URL.Path, prefix)
+			req.URL.RawPath = strings.TrimPrefix(req.URL.RawPath, prefix)
+		}
+		proxy.ModifyResponse = func(resp *http.Response) error {
+			rewriteGuacResponse(resp, prefix)
+			return nil
+		}
+	}
+
 	proxy.ServeHTTP(c.Writer, c.Request)
 	return
 }
 
 // guaclogin takes two query parameters and serves a html page which runs some javascript to login the user to automatically login the user to guacamole.
 func (a *Agent) guaclogin(c *gin.Context) {
-	envTag := strings.Split(c.Request.Host, ".")[0]
+	envTag := a.envTagFromRequest(c)
 
 	_, ok := a.EnvPool.Envs[envTag]
 	if !ok {
@@ -85,6 +124,21 @@ func (a *Agent) guaclogin(c *gin.Context) {
 		return
 	}
 
+	// One-time login links carry a token instead of the credentials
+	if token := c.Query("token"); token != "" {
+		login, ok := a.loginTokens.consume(token, envTag)
+		if !ok {
+			c.JSON(http.StatusUnauthorized, ProxyResponse{Message: "login link is invalid or has expired"})
+			return
+		}
+		c.HTML(http.StatusOK, "guaclogin.html", gin.H{
+			"content":  "This is the guaclogin page",
+			"login":    login,
+			"guacBase": a.guacBasePath(envTag),
+		})
+		return
+	}
+
 	username := c.Query("username")
 	password := c.Query("password")
 	if username == "" || password == "" {
@@ -92,8 +146,65 @@ func (a *Agent) guaclogin(c *gin.Context) {
 		return
 	}
 	c.HTML(http.StatusOK, "guaclogin.html", gin.H{
-		"content": "This is the guaclogin page",
+		"content":  "This is the guaclogin page",
+		"guacBase": a.guacBasePath(envTag),
 	})
 }
 
+// Returns a link to the guaclogin page for an environment which logs in using a one-time login token
+func (a *Agent) guacLoginURL(envTag, token string) string {
+	return a.proxyURL(envTag, "/guaclogin") + "?token=" + url.QueryEscape(token)
+}
+
+// Returns a link to a page served by the proxy for an environment depending on the configured routing mode
+func (a *Agent) proxyURL(envTag, path string) string {
+	if a.config.ProxyRouting == ProxyRoutingPath {
+		return fmt.Sprintf("//%s%s%s", a.config.Host, eventPathPrefix(envTag), path)
+	}
+	return fmt.Sprintf("//%s.%s%s", envTag, a.config.Host, path)
+}
 
+// Returns the event tag of a proxy request depending on the configured routing mode
+func (a *Agent) envTagFromRequest(c *gin.Context) string {
+	if a.config.ProxyRouting == ProxyRoutingPath {
+		return c.Param("eventTag")
+	}
+	return strings.Split(c.Request.Host, ".")[0]
+}
+
+// Returns the path which guacamole for an environment is reachable on through the proxy
+func (a *Agent) guacBasePath(envTag string) string {
+	if a.config.ProxyRouting == ProxyRoutingPath {
+		return eventPathPrefix(envTag) + "/guacamole"
+	}
+	return "/guacamole"
+}
+
+func eventPathPrefix(envTag string) string {
+	return "/events/" + envTag
+}
+
+// Guacamole assumes it is served on /guacamole, so redirects and cookies from the container are
+// rewritten to point to the event path instead.
+func rewriteGuacResponse(resp *http.Response, prefix string) {
+	if location, err := url.Parse(resp.Header.Get("Location")); err == nil && strings.HasPrefix(location.Path, "/guacamole") {
+		location.Path = prefix + location.Path
+		resp.Header.Set("Location", location.String())
+	}
+
+	cookies := resp.Header.Values("Set-Cookie")
+	if len(cookies) == 0 {
+		return
+	}
+	resp.Header.Del("Set-Cookie")
+	for _, cookie := range cookies {
+		parts := strings.Split(cookie, ";")
+		for i, part := range parts {
+			attr := strings.TrimSpace(part)
+			if strings.HasPrefix(strings.ToLower(attr), "path=/guacamole") {
+				parts[i] = " Path=" + prefix + attr[len("path="):]
+			}
+		}
+		resp.Header.Add("Set-Cookie", strings.Join(parts, ";"))
+	}
+}
@@ -0,0 +1,115 @@
+package agent
+
+import (
+	"context"
+	"net"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/aau-network-security/haaukins-agent/internal/environment/lab/virtual"
+	"github.com/aau-network-security/haaukins-agent/pkg/proto"
+	"github.com/rs/zerolog/log"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+const (
+	healthCheckInterval = 30 * time.Second
+	healthCheckTimeout  = 5 * time.Second
+)
+
+// Dependencies of the agent which are reported as services by the health service.
+// The empty service reports the overall health of the agent.
+const (
+	HealthDocker    = "docker"
+	HealthVbox      = "vbox"
+	HealthWireguard = "wireguard"
+	HealthState     = "state"
+)
+
+// Registers the standard gRPC health service, and keeps the status of each dependency updated.
+// The agent is only serving if all of its dependencies are, and is not serving until they have been checked.
+func (a *Agent) RegisterHealthServer(s *grpc.Server) {
+	healthServer := health.NewServer()
+	for _, service := range []string{"", proto.Agent_ServiceDesc.ServiceName, HealthDocker, HealthVbox, HealthWireguard, HealthState} {
+		healthServer.SetServingStatus(service, healthpb.HealthCheckResponse_NOT_SERVING)
+	}
+	healthpb.RegisterHealthServer(s, healthServer)
+
+	go func() {
+		a.updateHealth(healthServer)
+		ticker := time.NewTicker(healthCheckInterval)
+		defer ticker.Stop()
+		for range ticker.C {
+			a.updateHealth(healthServer)
+		}
+	}()
+}
+
+func (a *Agent) updateHealth(healthServer *health.Server) {
+	checks := map[string]func(context.Context) error{
+		HealthDocker:    checkDocker,
+		HealthVbox:      checkVbox,
+		HealthWireguard: a.checkWireguard,
+		HealthState:     a.checkState,
+	}
+
+	overall := healthpb.HealthCheckResponse_SERVING
+	for service, check := range checks {
+		ctx, cancel := context.WithTimeout(context.Background(), healthCheckTimeout)
+		err := check(ctx)
+		cancel()
+
+		status := healthpb.HealthCheckResponse_SERVING
+		if err != nil {
+			log.Warn().Err(err).Str("service", service).Msg("health check failed")
+			status = healthpb.HealthCheckResponse_NOT_SERVING
+			overall = healthpb.HealthCheckResponse_NOT_SERVING
+		}
+		healthServer.SetServingStatus(service, status)
+	}
+	healthServer.SetServingStatus("", overall)
+	healthServer.SetServingStatus(proto.Agent_ServiceDesc.ServiceName, overall)
+}
+
+func checkDocker(ctx context.Context) error {
+	return virtual.DefaultClient.PingWithContext(ctx)
+}
+
+func checkVbox(ctx context.Context) error {
+	_, err := virtual.VBoxCmdContext(ctx, "--version")
+	return err
+}
+
+// Checks that the WireGuard service of the agent can be reached
+func (a *Agent) checkWireguard(ctx context.Context) error {
+	address := net.JoinHostPort(a.config.VPNService.Endpoint, strconv.FormatUint(a.config.VPNService.Port, 10))
+	var d net.Dialer
+	conn, err := d.DialContext(ctx, "tcp", address)
+	if err != nil {
+		return err
+	}
+	return conn.Close()
+}
+
+// Checks that the state can be saved in the state directory
+func (a *Agent) checkState(ctx context.Context) error {
+	f, err := os.CreateTemp(a.config.StatePath, ".healthcheck-*")
+	if err != nil {
+		return err
+	}
+	name := f.Name()
+	f.Close()
+	return os.Remove(name)
+}
+
+func isHealthMethod(fullMethod string) bool {
+	return strings.HasPrefix(fullMethod, "/"+healthpb.Health_ServiceDesc.ServiceName+"/")
+}
+
+func isReflectionMethod(fullMethod string) bool {
+	return strings.HasPrefix(fullMethod, "/grpc.reflection.")
+}
@@ -0,0 +1,79 @@
+package agent
+
+import (
+	"context"
+	"runtime"
+
+	"github.com/aau-network-security/haaukins-agent/internal/environment"
+	"github.com/aau-network-security/haaukins-agent/internal/environment/lab"
+	"github.com/aau-network-security/haaukins-agent/pkg/proto"
+	"github.com/rs/zerolog/log"
+)
+
+// Version of the agent, set at compile time
+type BuildInfo struct {
+	Version     string
+	CompileDate string
+}
+
+var (
+	labTypes         = []lab.LabType{lab.TypeBeginner, lab.TypeAdvanced}
+	frontendBackends = []string{"guacamole", "terminal"}
+	vpnBackends      = []string{"wireguard"}
+)
+
+// Returns the version of the agent and what it supports, so the daemon can schedule events
+// only onto agents which support what the event needs
+func (a *Agent) GetAgentInfo(ctx context.Context, req *proto.Empty) (*proto.AgentInfoResponse, error) {
+	ovas, err := a.vlib.AvailableImages()
+	if err != nil {
+		log.Error().Err(err).Str("ovaDir", a.config.OvaDir).Msg("error listing ovas")
+		return nil, err
+	}
+
+	var types []string
+	for _, t := range labTypes {
+		types = append(types, t.String())
+	}
+
+	var registries []string
+	for _, repo := range a.config.DockerRepositories {
+		registries = append(registries, repo.ServerAddress)
+	}
+
+	return &proto.AgentInfoResponse{
+		Version:          a.build.Version,
+		CompileDate:      a.build.CompileDate,
+		GoVersion:        runtime.Version(),
+		Os:               runtime.GOOS,
+		Arch:             runtime.GOARCH,
+		LabTypes:         types,
+		FrontendBackends: frontendBackends,
+		VpnBackends:      vpnBackends,
+		Ovas:             ovas,
+		Registries:       registries,
+		Features:         a.features(),
+	}, nil
+}
+
+// Optional features of the agent and whether they are enabled
+func (a *Agent) features() map[string]bool {
+	return map[string]bool{
+		"tls":                 a.config.TLS.Enabled,
+		"client-certificates": a.config.TLS.Enabled && a.config.TLS.CAFile != "",
+		"path-routing":        a.config.ProxyRouting == ProxyRoutingPath,
+		"gateway":             a.config.Gateway.Enabled,
+		"metrics":             a.config.MetricsPort != 0,
+		"tracing":             a.config.Tracing.Enabled,
+		"rate-limit":          a.config.RateLimit != RateLimitConf{},
+		"recording-convert":   environment.GuacencAvailable(),
+		"spectators":          true,
+		"terminal":            true,
+		"exposed-services":    true,
+		"team-members":        true,
+		"audit-log":           true,
+		"token-scopes":        true,
+		"lab-usage":           true,
+		"log-level":           true,
+	}
+}
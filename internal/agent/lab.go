@@ -3,17 +3,19 @@ package agent
 import (
 	"context"
 	"encoding/json"
-	"errors"
 	"fmt"
-	"strconv"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/aau-network-security/haaukins-agent/internal/environment"
 	"github.com/aau-network-security/haaukins-agent/internal/environment/lab"
 	"github.com/aau-network-security/haaukins-agent/internal/environment/lab/exercise"
 	"github.com/aau-network-security/haaukins-agent/internal/environment/lab/virtual"
+	"github.com/aau-network-security/haaukins-agent/internal/logging"
+	"github.com/aau-network-security/haaukins-agent/internal/metrics"
 	"github.com/aau-network-security/haaukins-agent/internal/state"
+	"github.com/aau-network-security/haaukins-agent/internal/worker"
 	"github.com/aau-network-security/haaukins-agent/pkg/proto"
 	"github.com/rs/zerolog/log"
 )
@@ -21,41 +23,50 @@ import (
 func (a *Agent) CreateLabForEnv(ctx context.Context, req *proto.CreateLabRequest) (*proto.StatusResponse, error) {
 	env, err := a.EnvPool.GetEnv(req.EventTag)
 	if err != nil {
-		return nil, errors.New("environment for event does not exist")
+		return nil, fmt.Errorf("%w with tag: %s", environment.EnvNotFoundErr, req.EventTag)
 	}
 
 	if env.EnvConfig.Type == lab.TypeBeginner && req.IsVPN {
-		return nil, errors.New("cannot create vpn lab for beginner environment")
+		return nil, fmt.Errorf("%w: cannot create vpn lab for beginner environment", lab.InvalidLabTypeErr)
 	}
 
 	ec := env.EnvConfig
 
 	m := &sync.RWMutex{}
-	ec.WorkerPool.AddTask(func() {
-		ctx := context.Background()
-		log.Debug().Uint8("envStatus", uint8(ec.Status)).Msg("environment status when starting worker")
+	// A team is waiting for the lab
+	// The task is canceled if the environment is closed before the lab is created
+	opts := worker.TaskOpts{Name: "create lab", EnvTag: req.EventTag, Priority: worker.PriorityInteractive, Retry: a.labRetryPolicy()}
+	_, err = a.addTask(ctx, opts, func(ctx context.Context) error {
+		start := time.Now()
+		logging.Ctx(ctx).Debug().Uint8("envStatus", uint8(ec.Status)).Msg("environment status when starting worker")
 		// Make sure that environment is still running before creating lab
 		if ec.Status == environment.StatusClosing || ec.Status == environment.StatusClosed {
-			log.Info().Msg("environment closed before newlab task was taken from queue, canceling...")
-			return
+			logging.Ctx(ctx).Info().Msg("environment closed before newlab task was taken from queue, canceling...")
+			return context.Canceled
 		}
 
 		// Creating containers etc.
 		l, err := ec.LabConf.NewLab(ctx, req.IsVPN, ec.Type, ec.Tag)
 		if err != nil {
-			log.Error().Err(err).Str("eventTag", env.EnvConfig.Tag).Msg("error creating new lab")
-			return
+			logging.Ctx(ctx).Error().Err(err).Msg("error creating new lab")
+			return err
 		}
+		ctx = logging.With(ctx, logging.LabTag, l.Tag)
 		// Starting the created containers and frontends
+		stageStart := time.Now()
 		if err := l.Start(ctx); err != nil {
-			log.Error().Err(err).Str("eventTag", env.EnvConfig.Tag).Msg("error starting new lab")
-			return
+			logging.Ctx(ctx).Error().Err(err).Msg("error starting new lab")
+			closeFailedLab(ctx, &l)
+			return err
 		}
+		metrics.ObserveLabStage(ec.Tag, metrics.StageStart, stageStart)
 
+		stageStart = time.Now()
 		if !l.IsVPN {
-			if err := env.CreateGuacConn(l); err != nil {
-				log.Error().Err(err).Str("labTag", l.Tag).Msg("error creating guac connection for lab")
+			if err := env.CreateGuacConn(ctx, &l); err != nil {
+				logging.Ctx(ctx).Error().Err(err).Msg("error creating guac connection for lab")
 			}
+			metrics.ObserveLabStage(ec.Tag, metrics.StageGuac, stageStart)
 		} else {
 			env.M.Lock()
 
@@ -70,7 +81,7 @@ func (a *Agent) CreateLabForEnv(ctx context.Context, req *proto.CreateLabRequest
 				TeamSize:        env.EnvConfig.TeamSize,
 			}
 
-			labConfigsFiles, vpnIPs, _ := l.CreateVPNConfigs(env.Wg, req.EventTag, vpnConfig)
+			labConfigsFiles, vpnIPs, _ := l.CreateVPNConfigs(ctx, env.Wg, req.EventTag, vpnConfig)
 
 			env.IpT.CreateRejectRule(labSubnet)
 			env.IpT.CreateStateRule(labSubnet)
@@ -81,20 +92,21 @@ func (a *Agent) CreateLabForEnv(ctx context.Context, req *proto.CreateLabRequest
 			}
 			l.VpnConfs = labConfigsFiles
 			env.M.Unlock()
+			metrics.ObserveLabStage(ec.Tag, metrics.StageVPN, stageStart)
 		}
 
-		log.Debug().Uint8("envStatus", uint8(ec.Status)).Msg("environment status when ending worker")
-		if ec.Status == environment.StatusClosing || ec.Status == environment.StatusClosed {
-			log.Info().Msg("environment closed while newlab task was running from queue, closing lab...")
-			if err := l.Close(); err != nil {
-				log.Error().Err(err).Msg("error closing lab")
-				return
+		logging.Ctx(ctx).Debug().Uint8("envStatus", uint8(ec.Status)).Msg("environment status when ending worker")
+		if ec.Status == environment.StatusClosing || ec.Status == environment.StatusClosed || ctx.Err() != nil {
+			logging.Ctx(ctx).Info().Msg("environment closed while newlab task was running from queue, closing lab...")
+			if err := l.Close(ctx); err != nil {
+				logging.Ctx(ctx).Error().Err(err).Msg("error closing lab")
+				return err
 			}
-			return
+			return context.Canceled
 		}
 
 		// Sending lab info to daemon
-		newLab := proto.Lab{
+		newLab := &proto.Lab{
 			Tag:       l.Tag,
 			EventTag:  ec.Tag,
 			Exercises: l.GetExercisesInfo(),
@@ -103,23 +115,40 @@ func (a *Agent) CreateLabForEnv(ctx context.Context, req *proto.CreateLabRequest
 				Username: l.GuacUsername,
 				Password: l.GuacPassword,
 			},
-			VpnConfs: l.VpnConfs,
+			VpnConfs:        l.VpnConfs,
+			MemberCreds:     protoMemberCreds(&l),
+			ExposedServices: a.protoExposedServices(&l, ec.Tag),
+			Attempts:        int32(worker.Attempt(ctx)),
 		}
 
+		metrics.ObserveLabStage(ec.Tag, metrics.StageTotal, start)
 		//a.newLabs = append(a.newLabs, newLab)
-		a.newLabs <- newLab
+		a.newLabs.publish(newLab)
 		m.Lock()
 		env.Labs[l.Tag] = &l
 		m.Unlock()
 		// Should not be removed as it runs inside a worker
 		if err := state.SaveState(a.EnvPool, a.config.StatePath); err != nil {
-			log.Error().Err(err).Msg("error saving state")
+			logging.Ctx(ctx).Error().Err(err).Msg("error saving state")
 		}
-		// If lab was created while running CloseEnvironment, close the lab
+		return nil
 	})
+	if err != nil {
+		logging.Ctx(ctx).Warn().Err(err).Msg("error queueing new lab")
+		return nil, err
+	}
 	return &proto.StatusResponse{Message: "OK"}, nil
 }
 
+// Closes a lab which failed to start or was canceled while starting, so it is not left running
+// when the task is retried or given up
+func closeFailedLab(ctx context.Context, l *lab.Lab) {
+	logging.Ctx(ctx).Info().Msg("closing lab which failed to start...")
+	if err := l.Close(ctx); err != nil {
+		logging.Ctx(ctx).Error().Err(err).Msg("error closing lab which failed to start")
+	}
+}
+
 func (a *Agent) GetLab(ctx context.Context, req *proto.GetLabRequest) (*proto.GetLabResponse, error) {
 	l, err := a.EnvPool.GetLabByTag(req.LabTag)
 	if err != nil {
@@ -137,11 +166,27 @@ func (a *Agent) GetLab(ctx context.Context, req *proto.GetLabRequest) (*proto.Ge
 			Username: l.GuacUsername,
 			Password: l.GuacPassword,
 		},
-		VpnConfs: l.VpnConfs,
+		VpnConfs:        l.VpnConfs,
+		MemberCreds:     protoMemberCreds(l),
+		ExposedServices: a.protoExposedServices(l, eventTag),
 	}
 	return &proto.GetLabResponse{Lab: labToReturn}, nil
 }
 
+// Returns the guacamole credentials of each team member in a lab
+func protoMemberCreds(l *lab.Lab) []*proto.GuacCreds {
+	var creds []*proto.GuacCreds
+	for _, m := range l.Members {
+		creds = append(creds, &proto.GuacCreds{
+			Username:      m.GuacUsername,
+			Password:      m.GuacPassword,
+			RdpPort:       uint32(m.RdpPort),
+			FrontendImage: l.Frontends[m.RdpPort].Conf.Image,
+		})
+	}
+	return creds
+}
+
 func (a *Agent) CreateVpnConfForLab(ctx context.Context, req *proto.CreateVpnConfRequest) (*proto.CreateVpnConfResponse, error) {
 	l, err := a.EnvPool.GetLabByTag(req.LabTag)
 	if err != nil {
@@ -150,7 +195,7 @@ func (a *Agent) CreateVpnConfForLab(ctx context.Context, req *proto.CreateVpnCon
 	}
 
 	if !l.IsVPN {
-		return nil, errors.New("cannot create vpn connection for lab that is not a VPN lab")
+		return nil, fmt.Errorf("%w: cannot create vpn connection for lab that is not a VPN lab", lab.NotVPNLabErr)
 	}
 
 	envTag := strings.Split(l.Tag, "-")[0]
@@ -158,7 +203,7 @@ func (a *Agent) CreateVpnConfForLab(ctx context.Context, req *proto.CreateVpnCon
 	env, err := a.EnvPool.GetEnv(envTag)
 	if err != nil {
 		log.Error().Str("envTag", envTag).Msg("error finding finding environment with tag")
-		return nil, fmt.Errorf("error finding environment with tag: %s", envTag)
+		return nil, fmt.Errorf("%w with tag: %s", environment.EnvNotFoundErr, envTag)
 	}
 	env.M.Lock()
 	defer func() {
@@ -169,7 +214,7 @@ func (a *Agent) CreateVpnConfForLab(ctx context.Context, req *proto.CreateVpnCon
 	}()
 
 	if _, ok := env.IpRules[l.Tag]; ok {
-		return nil, errors.New("VPN configs already generated for this lab")
+		return nil, lab.VPNConfsExistErr
 	}
 
 	labSubnet := fmt.Sprintf("%s/24", l.DhcpServer.Subnet)
@@ -183,7 +228,7 @@ func (a *Agent) CreateVpnConfForLab(ctx context.Context, req *proto.CreateVpnCon
 		TeamSize:        env.EnvConfig.TeamSize,
 	}
 
-	labConfigsFiles, vpnIPs, err := l.CreateVPNConfigs(env.Wg, envTag, vpnConfig)
+	labConfigsFiles, vpnIPs, err := l.CreateVPNConfigs(ctx, env.Wg, envTag, vpnConfig)
 
 	env.IpT.CreateRejectRule(labSubnet)
 	env.IpT.CreateStateRule(labSubnet)
@@ -216,67 +261,75 @@ func (a *Agent) ResetLab(ctx context.Context, req *proto.ResetLabRequest) (*prot
 		return nil, err
 	}
 
-	l.M.Lock()
 	defer func() {
-		l.M.Unlock()
 		if err := state.SaveState(a.EnvPool, a.config.StatePath); err != nil {
 			log.Error().Err(err).Msg("error saving state")
 		}
 	}()
-	// Reset the DHCP
-	if err := l.RefreshDHCP(ctx); err != nil {
-		log.Error().Err(err).Str("labTag", req.LabTag).Msg("error resetting DHCP")
-		return nil, err
-	}
-
-	// Reset the DNS
-	if err := l.RefreshDNS(ctx); err != nil {
-		log.Error().Err(err).Str("labTag", req.LabTag).Msg("error resetting DNS")
-		return nil, err
-	}
+	// A team is waiting for the reset, so it runs before the creation of initial labs
+	opts := worker.TaskOpts{Name: "reset lab", EnvTag: strings.Split(req.LabTag, "-")[0], LabTag: req.LabTag, Priority: worker.PriorityInteractive}
+	err = a.runTask(ctx, opts, func(ctx context.Context) error {
+		l.M.Lock()
+		defer l.M.Unlock()
+		// Reset the DHCP
+		if err := l.RefreshDHCP(ctx); err != nil {
+			log.Error().Err(err).Str("labTag", req.LabTag).Msg("error resetting DHCP")
+			return err
+		}
 
-	// Reset all existing exercises
-	for _, exercise := range l.Exercises {
-		if err := exercise.Reset(ctx); err != nil {
-			log.Error().Err(err).Str("exerciseTag", exercise.Tag).Msg("error resetting exercise")
-			return nil, err
+		// Reset the DNS
+		if err := l.RefreshDNS(ctx); err != nil {
+			log.Error().Err(err).Str("labTag", req.LabTag).Msg("error resetting DNS")
+			return err
 		}
-	}
 
-	// Stop then start all frontends
-	for _, conf := range l.Frontends {
-		switch conf.Vm.Info().State {
-		case virtual.Running:
-			if err := conf.Vm.Stop(); err != nil {
-				return nil, err
-			}
-			if err := conf.Vm.Start(ctx); err != nil {
-				return nil, err
-			}
-		case virtual.Stopped:
-			if err := conf.Vm.Start(ctx); err != nil {
-				return nil, err
+		// Reset all existing exercises
+		for _, exercise := range l.Exercises {
+			if err := exercise.Reset(ctx); err != nil {
+				log.Error().Err(err).Str("exerciseTag", exercise.Tag).Msg("error resetting exercise")
+				return err
 			}
-		case virtual.Suspended:
-			if err := conf.Vm.Start(ctx); err != nil {
-				return nil, err
-			}
-			if err := conf.Vm.Stop(); err != nil {
-				return nil, err
-			}
-			if err := conf.Vm.Start(ctx); err != nil {
-				return nil, err
-			}
-		case virtual.Error:
-			if err := conf.Vm.Create(ctx); err != nil {
-				return nil, err
-			}
-			if err := conf.Vm.Start(ctx); err != nil {
-				return nil, err
+		}
+
+		// Stop then start all frontends
+		for _, conf := range l.Frontends {
+			switch conf.Vm.Info().State {
+			case virtual.Running:
+				if err := conf.Vm.Stop(); err != nil {
+					return err
+				}
+				if err := conf.Vm.Start(ctx); err != nil {
+					return err
+				}
+			case virtual.Stopped:
+				if err := conf.Vm.Start(ctx); err != nil {
+					return err
+				}
+			case virtual.Suspended:
+				if err := conf.Vm.Start(ctx); err != nil {
+					return err
+				}
+				if err := conf.Vm.Stop(); err != nil {
+					return err
+				}
+				if err := conf.Vm.Start(ctx); err != nil {
+					return err
+				}
+			case virtual.Error:
+				if err := conf.Vm.Create(ctx); err != nil {
+					return err
+				}
+				if err := conf.Vm.Start(ctx); err != nil {
+					return err
+				}
 			}
 		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
 	}
-	
+
 	return &proto.StatusResponse{Message: "OK"}, nil
 }
 
@@ -291,51 +344,55 @@ func (a *Agent) ResetVmInLab(ctx context.Context, req *proto.VmRequest) (*proto.
 	env, err := a.EnvPool.GetEnv(envTag)
 	if err != nil {
 		log.Error().Str("envTag", envTag).Msg("error finding finding environment with tag")
-		return nil, fmt.Errorf("error finding environment with tag: %s", envTag)
+		return nil, fmt.Errorf("%w with tag: %s", environment.EnvNotFoundErr, envTag)
 	}
 
+	// A team is waiting for the reset, so it runs before the creation of initial labs
+	opts := worker.TaskOpts{Name: "reset vm", EnvTag: envTag, LabTag: l.Tag, Priority: worker.PriorityInteractive}
+
 	// In case teamsize is larger than one
-	// A connectionIdentifier is required to determine which vm to reset
+	// A guacUsername is required to determine which vm to reset
 	if env.EnvConfig.TeamSize > 1 {
-		portStr, err := env.Guac.GetPortFromConnectionIdentifier(req.ConnectionIdentifier)
-		if err != nil {
-			log.Error().Err(err).Msg("error getting port from connection identifier")
-			return nil, err
-		}
-
-		portInt, _ := strconv.Atoi(portStr)
-
-		log.Debug().Str("port", portStr).Msg("response from GetPortFromConnectionIdentifier")
-
-		// Checking the lab for frontends with the requested port
-		// This is to only allow a team to reset a vm within their own lab
-		// since the connectionIdentifier is untrusted input
-
-		l.M.Lock()
 		defer func() {
-			l.M.Unlock()
 			if err := state.SaveState(a.EnvPool, a.config.StatePath); err != nil {
 				log.Error().Err(err).Msg("error saving state")
 			}
 		}()
-		if frontend, ok := l.Frontends[uint(portInt)]; ok {
-			log.Debug().Msgf("frontend from lab frontends: %v", frontend)
-			if err := l.ResetVm(ctx, uint(portInt), envTag); err != nil {
-				log.Error().Err(err).Msg("error resetting vm")
-				return nil, err
-			}
-			return &proto.StatusResponse{Message: "OK"}, nil
-		}
 
-		return nil, errors.New("frontend with that connection identifier not found in lab")
+		// Each member has their own guacamole user bound to a single frontend,
+		// so a reset can be attributed to the member requesting it
+		if req.GuacUsername == "" {
+			return nil, fmt.Errorf("%w to reset a vm", lab.MemberRequiredErr)
+		}
+		member, ok := l.MemberByUsername(req.GuacUsername)
+		if !ok {
+			return nil, fmt.Errorf("%w with guac username: %s", lab.MemberNotFoundErr, req.GuacUsername)
+		}
+		log.Info().Str("labTag", l.Tag).Str("guacUsername", member.GuacUsername).Uint("port", member.RdpPort).Msg("resetting vm for member")
+		err := a.runTask(ctx, opts, func(ctx context.Context) error {
+			l.M.Lock()
+			defer l.M.Unlock()
+			return l.ResetVm(ctx, member.RdpPort, envTag)
+		})
+		if err != nil {
+			log.Error().Err(err).Msg("error resetting vm")
+			return nil, err
+		}
+		return &proto.StatusResponse{Message: "OK"}, nil
 	} else {
-		l.M.Lock()
-		defer l.M.Unlock()
-		for port := range l.Frontends {
-			if err := l.ResetVm(ctx, port, envTag); err != nil {
-				log.Error().Err(err).Msg("error resetting vm")
-				return nil, err
+		err := a.runTask(ctx, opts, func(ctx context.Context) error {
+			l.M.Lock()
+			defer l.M.Unlock()
+			for port := range l.Frontends {
+				if err := l.ResetVm(ctx, port, envTag); err != nil {
+					return err
+				}
 			}
+			return nil
+		})
+		if err != nil {
+			log.Error().Err(err).Msg("error resetting vm")
+			return nil, err
 		}
 		return &proto.StatusResponse{Message: "OK"}, nil
 	}
@@ -353,13 +410,20 @@ func (a *Agent) CloseLab(ctx context.Context, req *proto.CloseLabRequest) (*prot
 			log.Error().Err(err).Msg("error saving state")
 		}
 	}()
-	a.workerPool.AddTask(func() {
+	opts := worker.TaskOpts{Name: "close lab", EnvTag: strings.Split(req.LabTag, "-")[0], LabTag: req.LabTag, Priority: worker.PriorityTeardown}
+	_, err = a.addTask(ctx, opts, func(ctx context.Context) error {
 		l.M.Lock()
 		defer l.M.Unlock()
-		if err := l.Close(); err != nil {
+		if err := l.Close(ctx); err != nil {
 			log.Error().Err(err).Msg("error closing lab")
+			return err
 		}
+		return nil
 	})
+	if err != nil {
+		log.Warn().Err(err).Str("labTag", req.LabTag).Msg("error queueing close of lab")
+		return nil, err
+	}
 
 	envKey := strings.Split(req.LabTag, "-")
 	env, _ := a.EnvPool.GetEnv(envKey[0])
@@ -388,7 +452,7 @@ func (a *Agent) AddExercisesToLab(ctx context.Context, req *proto.ExerciseReques
 	}
 
 	if l.Type == lab.TypeBeginner {
-		return nil, errors.New("cannot add arbitrary exercise to lab of type beginner")
+		return nil, fmt.Errorf("%w: cannot add arbitrary exercise to lab of type beginner", lab.InvalidLabTypeErr)
 	}
 
 	// Unpack into exercise slice
@@ -413,7 +477,7 @@ func (a *Agent) AddExercisesToLab(ctx context.Context, req *proto.ExerciseReques
 	ctx = context.Background()
 	if err := l.AddAndStartExercises(ctx, exerConfs...); err != nil {
 		log.Error().Err(err).Msg("error adding and starting exercises")
-		return nil, fmt.Errorf("error adding and starting exercises: %v", err)
+		return nil, fmt.Errorf("error adding and starting exercises: %w", err)
 	}
 
 	// TODO: Need to return host information back to daemon to display to user in case of VPN lab
@@ -478,10 +542,15 @@ func (a *Agent) ResetExerciseInLab(ctx context.Context, req *proto.ExerciseReque
 		}
 	}()
 
-	ctx = context.Background()
-	if err := l.ResetExercise(ctx, req.Exercise); err != nil {
+	// A team is waiting for the reset, so it runs before the creation of initial labs.
+	// Tasks run with their own context, so the reset is not stopped if the call is canceled
+	opts := worker.TaskOpts{Name: "reset exercise", EnvTag: strings.Split(req.LabTag, "-")[0], LabTag: req.LabTag, Priority: worker.PriorityInteractive}
+	err = a.runTask(ctx, opts, func(ctx context.Context) error {
+		return l.ResetExercise(ctx, req.Exercise)
+	})
+	if err != nil {
 		log.Error().Err(err).Msg("error resetting exercise")
-		return nil, errors.New("error resetting exercise")
+		return nil, fmt.Errorf("error resetting exercise: %w", err)
 	}
 
 	return &proto.StatusResponse{Message: "OK"}, nil
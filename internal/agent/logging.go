@@ -0,0 +1,128 @@
+package agent
+
+import (
+	"context"
+	"io"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/aau-network-security/haaukins-agent/internal/logging"
+	"github.com/aau-network-security/haaukins-agent/pkg/proto"
+	"github.com/rs/zerolog"
+	"github.com/rs/zerolog/log"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gopkg.in/natefinch/lumberjack.v2"
+)
+
+const (
+	LogFormatConsole = "console"
+	LogFormatJSON    = "json"
+)
+
+// Sets the level, format and output of the global logger from the config
+func SetupLogging(conf LoggingConf) error {
+	level, err := zerolog.ParseLevel(conf.Level)
+	if err != nil {
+		return err
+	}
+
+	var out io.Writer = os.Stderr
+	if conf.File != "" {
+		out = &lumberjack.Logger{
+			Filename:   conf.File,
+			MaxSize:    conf.MaxSizeMB,
+			MaxBackups: conf.MaxBackups,
+			MaxAge:     conf.MaxAgeDays,
+		}
+	}
+	if conf.Format == LogFormatConsole {
+		out = zerolog.ConsoleWriter{Out: out, NoColor: conf.File != ""}
+	}
+
+	zerolog.SetGlobalLevel(level)
+	log.Logger = zerolog.New(out).With().Timestamp().Logger()
+	return nil
+}
+
+// Level of the global logger which can be changed while debugging, and restored to the configured level later
+type logLevelControl struct {
+	m          sync.Mutex
+	configured zerolog.Level
+	reset      *time.Timer
+}
+
+func newLogLevelControl(level string) *logLevelControl {
+	configured, err := zerolog.ParseLevel(level)
+	if err != nil {
+		configured = zerolog.GlobalLevel()
+	}
+	return &logLevelControl{configured: configured}
+}
+
+// Sets the global log level. If resetAfter is above zero, the configured level is restored after it has passed
+func (c *logLevelControl) set(level zerolog.Level, resetAfter time.Duration) zerolog.Level {
+	c.m.Lock()
+	defer c.m.Unlock()
+
+	if c.reset != nil {
+		c.reset.Stop()
+		c.reset = nil
+	}
+	previous := zerolog.GlobalLevel()
+	zerolog.SetGlobalLevel(level)
+
+	if resetAfter > 0 {
+		c.reset = time.AfterFunc(resetAfter, func() {
+			c.m.Lock()
+			defer c.m.Unlock()
+			zerolog.SetGlobalLevel(c.configured)
+			c.reset = nil
+			log.Info().Str("level", c.configured.String()).Msg("restored configured log level")
+		})
+	}
+	return previous
+}
+
+// Changes the level of the agent log without restarting, like enabling debug logging while looking into a problem
+func (a *Agent) SetLogLevel(ctx context.Context, req *proto.SetLogLevelRequest) (*proto.SetLogLevelResponse, error) {
+	level, err := zerolog.ParseLevel(req.Level)
+	if err != nil || req.Level == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "unknown log level \"%s\"", req.Level)
+	}
+	if req.ResetAfter < 0 {
+		return nil, status.Error(codes.InvalidArgument, "resetAfter cannot be negative")
+	}
+
+	resetAfter := time.Duration(req.ResetAfter) * time.Second
+	previous := a.logLevel.set(level, resetAfter)
+	log.Info().Str("previousLevel", previous.String()).Str("level", level.String()).Dur("resetAfter", resetAfter).Msg("log level changed")
+
+	return &proto.SetLogLevelResponse{
+		PreviousLevel: previous.String(),
+		Level:         level.String(),
+	}, nil
+}
+
+// Adds a logger to the context of every call with the tags of the environment, lab and exercise the call concerns,
+// which is passed on through the call chain and to the tasks started by the call
+func (a *Agent) loggingUnaryInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	return handler(callLogContext(ctx, req), req)
+}
+
+func callLogContext(ctx context.Context, req interface{}) context.Context {
+	if r, ok := req.(interface{ GetEventTag() string }); ok && r.GetEventTag() != "" {
+		ctx = logging.With(ctx, logging.EnvTag, r.GetEventTag())
+	} else if r, ok := req.(interface{ GetEnvTag() string }); ok && r.GetEnvTag() != "" {
+		ctx = logging.With(ctx, logging.EnvTag, r.GetEnvTag())
+	}
+	if r, ok := req.(interface{ GetLabTag() string }); ok && r.GetLabTag() != "" {
+		ctx = logging.With(ctx, logging.LabTag, r.GetLabTag())
+	}
+	if r, ok := req.(interface{ GetExercise() string }); ok && r.GetExercise() != "" {
+		ctx = logging.With(ctx, logging.ExerciseTag, r.GetExercise())
+	}
+	return ctx
+}
@@ -0,0 +1,69 @@
+package agent
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"github.com/rs/zerolog/log"
+)
+
+var (
+	labsDesc       = prometheus.NewDesc("haaukins_agent_labs", "Labs running for the event.", []string{"event"}, nil)
+	exercisesDesc  = prometheus.NewDesc("haaukins_agent_exercises", "Exercises in the labs of the event.", []string{"event"}, nil)
+	containersDesc = prometheus.NewDesc("haaukins_agent_containers", "Containers in the labs of the event.", []string{"event"}, nil)
+	vmsDesc        = prometheus.NewDesc("haaukins_agent_vms", "VMs in the labs of the event.", []string{"event"}, nil)
+	vpnPeersDesc   = prometheus.NewDesc("haaukins_agent_vpn_peers", "VPN peers configured for the labs of the event.", []string{"event"}, nil)
+	queuedDesc     = prometheus.NewDesc("haaukins_agent_queued_tasks", "Tasks of the event waiting for a worker.", []string{"event"}, nil)
+)
+
+// Collects the resources of each environment when metrics are scraped, so environments
+// which are closed disappear from the metrics
+type envCollector struct {
+	a *Agent
+}
+
+func (c *envCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- labsDesc
+	ch <- exercisesDesc
+	ch <- containersDesc
+	ch <- vmsDesc
+	ch <- vpnPeersDesc
+	ch <- queuedDesc
+}
+
+func (c *envCollector) Collect(ch chan<- prometheus.Metric) {
+	for tag, s := range c.a.EnvPool.GetEnvStats() {
+		ch <- prometheus.MustNewConstMetric(labsDesc, prometheus.GaugeValue, float64(s.Labs), tag)
+		ch <- prometheus.MustNewConstMetric(exercisesDesc, prometheus.GaugeValue, float64(s.Exercises), tag)
+		ch <- prometheus.MustNewConstMetric(containersDesc, prometheus.GaugeValue, float64(s.Containers), tag)
+		ch <- prometheus.MustNewConstMetric(vmsDesc, prometheus.GaugeValue, float64(s.Vms), tag)
+		ch <- prometheus.MustNewConstMetric(vpnPeersDesc, prometheus.GaugeValue, float64(s.VpnPeers), tag)
+	}
+	for tag, queued := range c.a.workerPool.GetQueuedTasksByEnv() {
+		ch <- prometheus.MustNewConstMetric(queuedDesc, prometheus.GaugeValue, float64(queued), tag)
+	}
+}
+
+// Serves the Prometheus metrics of the agent on /metrics on the metrics port.
+// The port is separate from the proxy, since the metrics should not be public.
+func (a *Agent) RunMetrics() error {
+	if err := prometheus.Register(&envCollector{a: a}); err != nil {
+		return err
+	}
+
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", promhttp.Handler())
+
+	server := &http.Server{
+		Addr:    fmt.Sprintf("%s:%d", a.config.MetricsListen, a.config.MetricsPort),
+		Handler: mux,
+	}
+	log.Info().Str("address", server.Addr).Msg("serving metrics")
+	if tlsConfig := a.proxyTLSConfig(); tlsConfig != nil {
+		server.TLSConfig = tlsConfig
+		return server.ListenAndServeTLS("", "")
+	}
+	return server.ListenAndServe()
+}
@@ -2,13 +2,21 @@ package agent
 
 import (
 	"context"
+	"fmt"
 	"io"
+	"runtime"
+	"time"
 
 	"github.com/aau-network-security/haaukins-agent/internal/environment/lab/virtual"
 	"github.com/aau-network-security/haaukins-agent/pkg/proto"
 	"github.com/rs/zerolog/log"
 	"github.com/shirou/gopsutil/cpu"
+	"github.com/shirou/gopsutil/disk"
+	"github.com/shirou/gopsutil/load"
 	"github.com/shirou/gopsutil/mem"
+	psnet "github.com/shirou/gopsutil/net"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 // TODO Heartbeat, resource monitoring and log monitoring
@@ -26,74 +34,214 @@ func (a *Agent) Ping(ctx context.Context, req *proto.PingRequest) (*proto.PingRe
 	return &proto.PingResponse{Pong: "the hell is that kind of ping?"}, nil
 }
 
-// Monitoring stream will respond to Pings from the server with cpu, memory and any new labs that may have come since last request
+// Monitoring stream pushes host resources, lab usage and any new labs that may have come since the last push
+// every monitoring interval. A ping from the daemon triggers a push right away.
+// The stream keeps pushing if the daemon stops sending pings, until the daemon closes the stream.
+// New labs are only pushed to the daemon, not to dashboards monitoring through the http gateway.
 func (a *Agent) MonitorStream(stream proto.Agent_MonitorStreamServer) error {
 	log.Debug().Msg("client connected to monitoring stream")
+
+	var newLabs chan *proto.Lab
+	if !fromGateway(stream.Context()) {
+		newLabs = a.newLabs.subscribe()
+		defer a.newLabs.unsubscribe(newLabs)
+	}
+
+	pings := make(chan struct{}, 1)
+	recvErrs := make(chan error, 1)
+	go func() {
+		for {
+			if _, err := stream.Recv(); err != nil {
+				recvErrs <- err
+				return
+			}
+			select {
+			case pings <- struct{}{}:
+			default:
+			}
+		}
+	}()
+
+	ticker := time.NewTicker(time.Duration(a.config.Monitoring.Interval) * time.Second)
+	defer ticker.Stop()
+
+	var prevNet *netSample
 	for {
-		_, err := stream.Recv()
-		if err == io.EOF {
+		select {
+		case <-stream.Context().Done():
 			log.Debug().Msg("client closing connection")
 			return nil
-		}
-		if err != nil {
-			log.Error().Err(err).Msg("error recieving monitoring ping from client")
-			if err.Error() == "rpc error: code = Canceled desc = context canceled" {
+		case err := <-recvErrs:
+			if err == io.EOF {
+				log.Debug().Msg("client stopped sending pings, pushing until the stream is closed")
+				recvErrs = nil
+				continue
+			}
+			if status.Code(err) == codes.Canceled {
 				return nil
 			}
-			continue
-		}
-		cpuPerc, err := cpu.Percent(0, false)
-		if err != nil {
-			log.Error().Err(err).Msg("error reading cpu percentage")
-			cpuPerc = append(cpuPerc, 0)
+			log.Error().Err(err).Msg("error recieving monitoring ping from client")
+			return err
+		case <-pings:
+		case <-ticker.C:
 		}
-		memory, err := mem.VirtualMemory()
-		if err != nil {
-			log.Error().Err(err).Msg("error reading memory percentage")
-			memory = &mem.VirtualMemoryStat{}
-			memory.UsedPercent = 0
+
+		var resp *proto.MonitorResponse
+		resp, prevNet = a.monitorResponse(prevNet, newLabs)
+		if err := stream.Send(resp); err != nil {
+			log.Error().Err(err).Msg("error sending monitoring response")
+			return err
 		}
+	}
+}
 
-		containerCount, err := virtual.GetContainerCount()
-		if err != nil {
-			log.Error().Err(err).Msg("error getting container count")
+// Network counters of the host at the previous push, used to calculate the throughput since then
+type netSample struct {
+	rx, tx uint64
+	at     time.Time
+}
 
-		}
-		vmCount, err := virtual.GetRunningVmCount()
-		if err != nil {
-			log.Error().Err(err).Msg("error getting running vm count")
-		}
+func (a *Agent) monitorResponse(prevNet *netSample, newLabs chan *proto.Lab) (*proto.MonitorResponse, *netSample) {
+	resources, net := a.hostResources(prevNet)
 
-		resp := &proto.MonitorResponse{
-			Hb:          "alive",
-			QueuedTasks: a.workerPool.GetAmountOfQueuedTasks(),
-			Resources: &proto.Resources{
-				Cpu:            cpuPerc[0],
-				MemPercentUsed: memory.UsedPercent,
-				MemAvailable:   memory.Available,
-				MemInstalled:   memory.Total,
-				LabCount:       a.EnvPool.GetFullLabCount(),
-				ContainerCount: containerCount,
-				VmCount:        vmCount,
-			},
-		}
+	labsByEvent := make(map[string]uint32)
+	for tag, stats := range a.EnvPool.GetEnvStats() {
+		labsByEvent[tag] = uint32(stats.Labs)
+	}
 
-		// TODO add frontend info (Kali) to newlab
-	L:
-		for {
-			select {
-			case l, ok := <-a.newLabs:
-				if !ok { //closed
-					break L
-				}
-				resp.NewLabs = append(resp.NewLabs, &l)
-			default:
+	resp := &proto.MonitorResponse{
+		Hb:          "alive",
+		QueuedTasks: a.workerPool.GetAmountOfQueuedTasks(),
+		// Tasks are scheduled fairly between events, so the daemon can see which events are waiting
+		QueuedTasksByEvent: a.workerPool.GetQueuedTasksByEnv(),
+		// Requests rejected by the proxy rate limits since the agent started
+		RejectedRequests: a.limiter.rejected(),
+		Resources:        resources,
+		LabsByEvent:      labsByEvent,
+		LabUsage:         a.labUsage.protoLabUsage("", ""),
+		Warnings:         resourceWarnings(resources, a.config.Monitoring.Thresholds),
+	}
+
+	// TODO add frontend info (Kali) to newlab
+L:
+	for {
+		select {
+		case l, ok := <-newLabs:
+			if !ok { //closed
 				break L
 			}
+			resp.NewLabs = append(resp.NewLabs, l)
+		default:
+			break L
 		}
+	}
+	return resp, net
+}
 
-		if err := stream.Send(resp); err != nil {
-			log.Error().Err(err).Msg("error sending monitoring response")
+func (a *Agent) hostResources(prevNet *netSample) (*proto.Resources, *netSample) {
+	cpuPerc, err := cpu.Percent(0, false)
+	if err != nil || len(cpuPerc) == 0 {
+		log.Error().Err(err).Msg("error reading cpu percentage")
+		cpuPerc = append(cpuPerc, 0)
+	}
+	memory, err := mem.VirtualMemory()
+	if err != nil {
+		log.Error().Err(err).Msg("error reading memory percentage")
+		memory = &mem.VirtualMemoryStat{}
+		memory.UsedPercent = 0
+	}
+
+	containerCount, err := virtual.GetContainerCount()
+	if err != nil {
+		log.Error().Err(err).Msg("error getting container count")
+
+	}
+	vmCount, err := virtual.GetRunningVmCount()
+	if err != nil {
+		log.Error().Err(err).Msg("error getting running vm count")
+	}
+
+	resources := &proto.Resources{
+		Cpu:            cpuPerc[0],
+		MemPercentUsed: memory.UsedPercent,
+		MemAvailable:   memory.Available,
+		MemInstalled:   memory.Total,
+		LabCount:       a.EnvPool.GetFullLabCount(),
+		ContainerCount: containerCount,
+		VmCount:        vmCount,
+		CpuCores:       uint32(runtime.NumCPU()),
+		Disks:          a.diskUsage(),
+	}
+
+	if avg, err := load.Avg(); err != nil {
+		log.Error().Err(err).Msg("error reading load average")
+	} else {
+		resources.Load1 = avg.Load1
+		resources.Load5 = avg.Load5
+		resources.Load15 = avg.Load15
+	}
+
+	counters, err := psnet.IOCounters(false)
+	if err != nil || len(counters) == 0 {
+		log.Error().Err(err).Msg("error reading network counters")
+		return resources, prevNet
+	}
+	net := &netSample{rx: counters[0].BytesRecv, tx: counters[0].BytesSent, at: time.Now()}
+	if prevNet != nil && net.rx >= prevNet.rx && net.tx >= prevNet.tx {
+		if secs := net.at.Sub(prevNet.at).Seconds(); secs > 0 {
+			resources.NetRxBytesPerSec = uint64(float64(net.rx-prevNet.rx) / secs)
+			resources.NetTxBytesPerSec = uint64(float64(net.tx-prevNet.tx) / secs)
+		}
+	}
+	return resources, net
+}
+
+// Returns the usage of the disks holding the OVAs, the file transfer root and the docker root
+func (a *Agent) diskUsage() []*proto.DiskUsage {
+	paths := []struct{ name, path string }{
+		{"ova", a.config.OvaDir},
+		{"filetransfer", a.config.FileTransferRoot},
+	}
+	if dockerRoot, err := virtual.DockerRootDir(); err != nil {
+		log.Error().Err(err).Msg("error getting docker root dir")
+	} else {
+		paths = append(paths, struct{ name, path string }{"docker", dockerRoot})
+	}
+
+	var disks []*proto.DiskUsage
+	for _, p := range paths {
+		usage, err := disk.Usage(p.path)
+		if err != nil {
+			log.Error().Err(err).Str("path", p.path).Msg("error reading disk usage")
+			continue
 		}
+		disks = append(disks, &proto.DiskUsage{
+			Name:        p.name,
+			Path:        p.path,
+			Total:       usage.Total,
+			Used:        usage.Used,
+			UsedPercent: usage.UsedPercent,
+		})
+	}
+	return disks
+}
+
+// Returns a warning for every resource of the host above its threshold
+func resourceWarnings(r *proto.Resources, t ThresholdConf) []*proto.ResourceWarning {
+	var warnings []*proto.ResourceWarning
+	check := func(resource string, value, threshold float64) {
+		if value > threshold {
+			warnings = append(warnings, &proto.ResourceWarning{Resource: resource, Value: value, Threshold: threshold})
+		}
+	}
+
+	check("cpu", r.Cpu, t.CPUPercent)
+	check("memory", r.MemPercentUsed, t.MemoryPercent)
+	if r.CpuCores > 0 {
+		check("load", r.Load5/float64(r.CpuCores), t.LoadPerCore)
+	}
+	for _, d := range r.Disks {
+		check(fmt.Sprintf("disk:%s", d.Name), d.UsedPercent, t.DiskPercent)
 	}
+	return warnings
 }
@@ -15,6 +15,8 @@ type IPPool struct {
 	weights map[string]int
 }
 
+var NoVPNIPsErr = errors.New("no available VPN IPs")
+
 func randomPickWeighted(m map[string]int) string {
 	var totalWeight int
 	for _, w := range m {
@@ -38,7 +40,7 @@ func (ipp *IPPool) Get() (string, error) {
 	defer ipp.m.Unlock()
 
 	if len(ipp.ips) > 60000 {
-		return "", errors.New("no available VPN IPs")
+		return "", NoVPNIPsErr
 	}
 
 	genIP := func() string {
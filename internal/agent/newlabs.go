@@ -0,0 +1,80 @@
+package agent
+
+import (
+	"sync"
+
+	pb "github.com/aau-network-security/haaukins-agent/pkg/proto"
+	"github.com/rs/zerolog/log"
+)
+
+// Labs each monitoring stream of the daemon can have waiting to be pushed
+const newLabsBuffer = 1000
+
+// New labs for the monitoring streams of the daemon. Every stream gets each new lab on its own channel,
+// so a second stream does not take labs from another. Labs created while no stream is subscribed are kept
+// until the next stream subscribes, so the daemon gets them when it reconnects.
+type labFeed struct {
+	m       sync.Mutex
+	subs    map[chan *pb.Lab]struct{}
+	pending []*pb.Lab
+}
+
+func newLabFeed() *labFeed {
+	return &labFeed{subs: make(map[chan *pb.Lab]struct{})}
+}
+
+// Sends a new lab to every subscribed stream. A stream with a full buffer misses the lab instead of
+// blocking the worker which created it
+func (f *labFeed) publish(l *pb.Lab) {
+	f.m.Lock()
+	defer f.m.Unlock()
+
+	if len(f.subs) == 0 {
+		if len(f.pending) >= newLabsBuffer {
+			log.Warn().Str("labTag", l.Tag).Msg("no monitoring stream is receiving new labs, dropping new lab")
+			return
+		}
+		f.pending = append(f.pending, l)
+		return
+	}
+	for ch := range f.subs {
+		select {
+		case ch <- l:
+		default:
+			log.Warn().Str("labTag", l.Tag).Msg("monitoring stream is not receiving new labs, dropping new lab")
+		}
+	}
+}
+
+// Returns a channel receiving new labs, starting with the labs created while no stream was subscribed
+func (f *labFeed) subscribe() chan *pb.Lab {
+	f.m.Lock()
+	defer f.m.Unlock()
+
+	ch := make(chan *pb.Lab, newLabsBuffer)
+	for _, l := range f.pending {
+		ch <- l
+	}
+	f.pending = nil
+	f.subs[ch] = struct{}{}
+	return ch
+}
+
+// Removes a stream. If it was the last stream, the labs it had not pushed are kept for the next stream
+func (f *labFeed) unsubscribe(ch chan *pb.Lab) {
+	f.m.Lock()
+	defer f.m.Unlock()
+
+	delete(f.subs, ch)
+	if len(f.subs) > 0 {
+		return
+	}
+	for {
+		select {
+		case l := <-ch:
+			f.pending = append(f.pending, l)
+		default:
+			return
+		}
+	}
+}
@@ -0,0 +1,156 @@
+package agent
+
+import (
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gorilla/websocket"
+	"github.com/rs/zerolog/log"
+	"golang.org/x/time/rate"
+)
+
+// Limiters which have not been used for this long are removed
+const limiterIdleTimeout = 5 * time.Minute
+
+type visitor struct {
+	limiter  *rate.Limiter
+	lastSeen time.Time
+}
+
+// Limits the request rate and the amount of concurrent websocket tunnels through the proxy,
+// both per client IP and per event, so a single browser cannot flood the guacamole of an event.
+type proxyLimiter struct {
+	conf RateLimitConf
+
+	m              sync.Mutex
+	ipVisitors     map[string]*visitor
+	eventVisitors  map[string]*visitor
+	ipTunnels      map[string]int
+	eventTunnels   map[string]int
+	rejectedAmount uint64
+}
+
+func newProxyLimiter(conf RateLimitConf) *proxyLimiter {
+	return &proxyLimiter{
+		conf:          conf,
+		ipVisitors:    make(map[string]*visitor),
+		eventVisitors: make(map[string]*visitor),
+		ipTunnels:     make(map[string]int),
+		eventTunnels:  make(map[string]int),
+	}
+}
+
+// Returns false if the request rate has been exceeded for either the ip or the event
+func (pl *proxyLimiter) allow(ip, envTag string) bool {
+	pl.m.Lock()
+	defer pl.m.Unlock()
+
+	if pl.conf.RequestsPerIP > 0 && !pl.visitorLimiter(pl.ipVisitors, ip, pl.conf.RequestsPerIP, pl.conf.BurstPerIP).Allow() {
+		return false
+	}
+	if envTag != "" && pl.conf.RequestsPerEvent > 0 && !pl.visitorLimiter(pl.eventVisitors, envTag, pl.conf.RequestsPerEvent, pl.conf.BurstPerEvent).Allow() {
+		return false
+	}
+	return true
+}
+
+func (pl *proxyLimiter) visitorLimiter(visitors map[string]*visitor, key string, limit float64, burst int) *rate.Limiter {
+	v, ok := visitors[key]
+	if !ok {
+		if burst <= 0 {
+			burst = int(limit) + 1
+		}
+		v = &visitor{limiter: rate.NewLimiter(rate.Limit(limit), burst)}
+		visitors[key] = v
+	}
+	v.lastSeen = time.Now()
+	return v.limiter
+}
+
+// Reserves a websocket tunnel for the ip and event. Returns false if either has reached their maximum amount of tunnels
+func (pl *proxyLimiter) openTunnel(ip, envTag string) bool {
+	pl.m.Lock()
+	defer pl.m.Unlock()
+
+	if pl.conf.TunnelsPerIP > 0 && pl.ipTunnels[ip] >= pl.conf.TunnelsPerIP {
+		return false
+	}
+	if envTag != "" && pl.conf.TunnelsPerEvent > 0 && pl.eventTunnels[envTag] >= pl.conf.TunnelsPerEvent {
+		return false
+	}
+	pl.ipTunnels[ip]++
+	pl.eventTunnels[envTag]++
+	return true
+}
+
+func (pl *proxyLimiter) closeTunnel(ip, envTag string) {
+	pl.m.Lock()
+	defer pl.m.Unlock()
+
+	if pl.ipTunnels[ip]--; pl.ipTunnels[ip] <= 0 {
+		delete(pl.ipTunnels, ip)
+	}
+	if pl.eventTunnels[envTag]--; pl.eventTunnels[envTag] <= 0 {
+		delete(pl.eventTunnels, envTag)
+	}
+}
+
+func (pl *proxyLimiter) reject() {
+	atomic.AddUint64(&pl.rejectedAmount, 1)
+}
+
+// Returns the amount of requests rejected by the limiter since the agent started
+func (pl *proxyLimiter) rejected() uint64 {
+	return atomic.LoadUint64(&pl.rejectedAmount)
+}
+
+// Periodically removes limiters for ips and events which have not been seen for a while
+func (pl *proxyLimiter) removeIdleVisitors(interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for range ticker.C {
+		pl.m.Lock()
+		for _, visitors := range []map[string]*visitor{pl.ipVisitors, pl.eventVisitors} {
+			for key, v := range visitors {
+				if time.Since(v.lastSeen) > limiterIdleTimeout {
+					delete(visitors, key)
+				}
+			}
+		}
+		pl.m.Unlock()
+	}
+}
+
+// Middleware for the proxy which rejects requests with 429 when a rate limit or tunnel cap is exceeded
+func (a *Agent) rateLimit(c *gin.Context) {
+	ip := c.ClientIP()
+	envTag := a.envTagFromRequest(c)
+	if _, exposedEnvTag, ok := a.exposedServiceHost(c.Request.Host); ok {
+		envTag = exposedEnvTag
+	}
+
+	if !a.limiter.allow(ip, envTag) {
+		a.limiter.reject()
+		log.Debug().Str("ip", ip).Str("eventTag", envTag).Msg("rate limit exceeded for proxy request")
+		c.AbortWithStatusJSON(http.StatusTooManyRequests, ProxyResponse{Message: "too many requests"})
+		return
+	}
+
+	if !websocket.IsWebSocketUpgrade(c.Request) {
+		c.Next()
+		return
+	}
+
+	if !a.limiter.openTunnel(ip, envTag) {
+		a.limiter.reject()
+		log.Debug().Str("ip", ip).Str("eventTag", envTag).Msg("too many open tunnels for proxy request")
+		c.AbortWithStatusJSON(http.StatusTooManyRequests, ProxyResponse{Message: "too many open connections"})
+		return
+	}
+	// The handlers block until the websocket is closed
+	defer a.limiter.closeTunnel(ip, envTag)
+	c.Next()
+}
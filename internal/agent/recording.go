@@ -0,0 +1,83 @@
+package agent
+
+import (
+	"context"
+	"errors"
+	"io"
+	"os"
+
+	"github.com/aau-network-security/haaukins-agent/internal/environment"
+	"github.com/aau-network-security/haaukins-agent/pkg/proto"
+	"github.com/rs/zerolog/log"
+)
+
+const recordingChunkSize = 64 * 1024
+
+// Lists Guacamole session recordings for an event, optionally filtered by lab.
+// Recordings are read from disk, so they can be listed after the environment has been closed.
+func (a *Agent) ListRecordings(ctx context.Context, req *proto.RecordingRequest) (*proto.ListRecordingsResponse, error) {
+	recordings, err := environment.ListRecordings(req.EventTag, req.LabTag)
+	if err != nil {
+		log.Error().Err(err).Str("eventTag", req.EventTag).Msg("error listing recordings")
+		return nil, err
+	}
+
+	var protoRecordings []*proto.Recording
+	for _, r := range recordings {
+		protoRecordings = append(protoRecordings, protoRecording(r))
+	}
+
+	return &proto.ListRecordingsResponse{Recordings: protoRecordings}, nil
+}
+
+// Streams a session recording to the daemon in chunks
+func (a *Agent) DownloadRecording(req *proto.RecordingRequest, stream proto.Agent_DownloadRecordingServer) error {
+	path, err := environment.GetRecordingPath(req.EventTag, req.LabTag, req.Name)
+	if err != nil {
+		log.Error().Err(err).Str("eventTag", req.EventTag).Str("labTag", req.LabTag).Msg("error getting recording")
+		return err
+	}
+
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	buf := make([]byte, recordingChunkSize)
+	for {
+		n, err := f.Read(buf)
+		if n > 0 {
+			if err := stream.Send(&proto.RecordingChunk{Data: buf[:n]}); err != nil {
+				return err
+			}
+		}
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+	}
+}
+
+// Converts a session recording into a video which can be downloaded using DownloadRecording.
+// Requires guacenc to be installed on the agent.
+func (a *Agent) ConvertRecording(ctx context.Context, req *proto.RecordingRequest) (*proto.ConvertRecordingResponse, error) {
+	recording, err := environment.ConvertRecording(req.EventTag, req.LabTag, req.Name)
+	if err != nil {
+		log.Error().Err(err).Str("eventTag", req.EventTag).Str("labTag", req.LabTag).Msg("error converting recording")
+		return nil, err
+	}
+
+	return &proto.ConvertRecordingResponse{Recording: protoRecording(recording)}, nil
+}
+
+func protoRecording(r environment.Recording) *proto.Recording {
+	return &proto.Recording{
+		LabTag:  r.LabTag,
+		Name:    r.Name,
+		Size:    uint64(r.Size),
+		ModTime: r.ModTime.Unix(),
+	}
+}
@@ -0,0 +1,153 @@
+package agent
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aau-network-security/haaukins-agent/internal/environment"
+	"github.com/aau-network-security/haaukins-agent/internal/environment/lab"
+	"github.com/aau-network-security/haaukins-agent/internal/state"
+	"github.com/aau-network-security/haaukins-agent/pkg/proto"
+	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	defaultSpectatorExpiry = 30 * time.Minute
+	maxSpectatorExpiry     = 24 * time.Hour
+)
+
+// Credentials for a guacamole user which can be exchanged once for a login through the guac proxy
+type guacLogin struct {
+	Username  string `json:"username"`
+	Password  string `json:"password"`
+	envTag    string
+	expiresAt time.Time
+}
+
+type loginTokenStore struct {
+	m      sync.Mutex
+	tokens map[string]guacLogin
+}
+
+func newLoginTokenStore() *loginTokenStore {
+	return &loginTokenStore{
+		tokens: make(map[string]guacLogin),
+	}
+}
+
+// Adds a login to the store and returns the token which can be used to retrieve it
+func (s *loginTokenStore) add(login guacLogin) string {
+	s.m.Lock()
+	defer s.m.Unlock()
+
+	token := strings.ReplaceAll(uuid.New().String(), "-", "")
+	s.tokens[token] = login
+	return token
+}
+
+// Returns the login for a token and removes it from the store, so a token can only be used once
+func (s *loginTokenStore) consume(token, envTag string) (guacLogin, bool) {
+	s.m.Lock()
+	defer s.m.Unlock()
+
+	login, ok := s.tokens[token]
+	if !ok || login.envTag != envTag {
+		return guacLogin{}, false
+	}
+	delete(s.tokens, token)
+
+	if time.Now().After(login.expiresAt) {
+		return guacLogin{}, false
+	}
+	return login, true
+}
+
+func (s *loginTokenStore) removeExpired() {
+	s.m.Lock()
+	defer s.m.Unlock()
+
+	for token, login := range s.tokens {
+		if time.Now().After(login.expiresAt) {
+			delete(s.tokens, token)
+		}
+	}
+}
+
+// Creates a guacamole user with read-only access to the frontends of a lab, so mentors can watch a team without taking control.
+// Returns a one-time login link through the guac proxy. The access is removed again when it expires.
+func (a *Agent) CreateSpectatorAccess(ctx context.Context, req *proto.SpectatorRequest) (*proto.SpectatorResponse, error) {
+	l, err := a.EnvPool.GetLabByTag(req.LabTag)
+	if err != nil {
+		log.Error().Str("labTag", req.LabTag).Err(err).Msg("error getting lab by tag")
+		return nil, err
+	}
+
+	if l.IsVPN {
+		return nil, fmt.Errorf("%w: cannot spectate a VPN lab as it has no frontends", lab.VPNLabErr)
+	}
+
+	envTag := strings.Split(l.Tag, "-")[0]
+	env, err := a.EnvPool.GetEnv(envTag)
+	if err != nil {
+		log.Error().Str("envTag", envTag).Msg("error finding finding environment with tag")
+		return nil, fmt.Errorf("%w with tag: %s", environment.EnvNotFoundErr, envTag)
+	}
+
+	expiry := defaultSpectatorExpiry
+	if req.ExpiryMinutes > 0 {
+		expiry = time.Duration(req.ExpiryMinutes) * time.Minute
+	}
+	if expiry > maxSpectatorExpiry {
+		expiry = maxSpectatorExpiry
+	}
+
+	l.M.RLock()
+	spectator, err := env.CreateSpectator(ctx, l, expiry)
+	l.M.RUnlock()
+	if err != nil {
+		log.Error().Err(err).Str("labTag", req.LabTag).Msg("error creating spectator")
+		return nil, err
+	}
+
+	if err := state.SaveState(a.EnvPool, a.config.StatePath); err != nil {
+		log.Error().Err(err).Msg("error saving state")
+	}
+
+	token := a.loginTokens.add(guacLogin{
+		Username:  spectator.Username,
+		Password:  spectator.Password,
+		envTag:    envTag,
+		expiresAt: spectator.ExpiresAt,
+	})
+
+	return &proto.SpectatorResponse{
+		LoginUrl:  a.guacLoginURL(envTag, token),
+		ExpiresAt: spectator.ExpiresAt.Unix(),
+	}, nil
+}
+
+// Periodically removes expired spectators from all environments as well as unused login tokens
+func (a *Agent) removeExpiredSpectators(interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for range ticker.C {
+		a.loginTokens.removeExpired()
+
+		a.EnvPool.M.RLock()
+		var removed int
+		for _, env := range a.EnvPool.Envs {
+			removed += env.RemoveExpiredSpectators()
+		}
+		a.EnvPool.M.RUnlock()
+
+		if removed > 0 {
+			if err := state.SaveState(a.EnvPool, a.config.StatePath); err != nil {
+				log.Error().Err(err).Msg("error saving state")
+			}
+		}
+	}
+}
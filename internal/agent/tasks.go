@@ -0,0 +1,39 @@
+package agent
+
+import (
+	"context"
+	"time"
+
+	"github.com/aau-network-security/haaukins-agent/pkg/proto"
+)
+
+// Lists the queued, running and recently finished tasks of the worker pool, optionally only for an event
+func (a *Agent) ListTasks(ctx context.Context, req *proto.ListTasksRequest) (*proto.ListTasksResponse, error) {
+	var tasks []*proto.Task
+	for _, t := range a.workerPool.ListTasks(req.EventTag) {
+		task := &proto.Task{
+			Id:         t.ID,
+			Name:       t.Name,
+			EventTag:   t.EnvTag,
+			LabTag:     t.LabTag,
+			Priority:   t.Priority.String(),
+			State:      t.State.String(),
+			CreatedAt:  t.CreatedAt.Unix(),
+			StartedAt:  unixOrZero(t.StartedAt),
+			FinishedAt: unixOrZero(t.FinishedAt),
+			Attempts:   int32(t.Attempts),
+		}
+		if t.Err != nil {
+			task.Error = t.Err.Error()
+		}
+		tasks = append(tasks, task)
+	}
+	return &proto.ListTasksResponse{Tasks: tasks}, nil
+}
+
+func unixOrZero(t time.Time) int64 {
+	if t.IsZero() {
+		return 0
+	}
+	return t.Unix()
+}
@@ -0,0 +1,222 @@
+package agent
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aau-network-security/haaukins-agent/internal/environment/lab/virtual"
+	"github.com/aau-network-security/haaukins-agent/pkg/proto"
+	"github.com/gin-gonic/gin"
+	jwt "github.com/golang-jwt/jwt/v4"
+	"github.com/gorilla/websocket"
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	LAB_KEY = "lab"
+
+	// Audience of lab tokens, so they cannot be used as tokens for the gRPC API and the other way around
+	terminalAudience = "terminal"
+
+	defaultTerminalExpiry = 8 * time.Hour
+	maxTerminalExpiry     = 24 * time.Hour
+)
+
+var (
+	terminalCmd = []string{"/bin/sh", "-c", "if command -v bash > /dev/null; then exec bash; else exec sh; fi"}
+
+	terminalUpgrader = websocket.Upgrader{
+		ReadBufferSize:  1024,
+		WriteBufferSize: 1024,
+		// The lab token in the query authenticates the request, so any origin is allowed like the rest of the proxy
+		CheckOrigin: func(r *http.Request) bool { return true },
+	}
+)
+
+// Message sent from xterm.js in the browser to the terminal websocket
+type terminalMessage struct {
+	Type string `json:"type"`
+	Data string `json:"data,omitempty"`
+	Cols uint   `json:"cols,omitempty"`
+	Rows uint   `json:"rows,omitempty"`
+}
+
+// Writes the output of the TTY to the websocket as binary messages
+type terminalWriter struct {
+	m    sync.Mutex
+	conn *websocket.Conn
+}
+
+func (w *terminalWriter) Write(p []byte) (int, error) {
+	w.m.Lock()
+	defer w.m.Unlock()
+	if err := w.conn.WriteMessage(websocket.BinaryMessage, p); err != nil {
+		return 0, err
+	}
+	return len(p), nil
+}
+
+// Creates a link to a browser terminal for a lab, which opens a shell in the container of the lab configured with a terminal.
+// This gives teams without VPN a shell for container only exercises without needing a frontend.
+func (a *Agent) CreateTerminalAccess(ctx context.Context, req *proto.TerminalRequest) (*proto.TerminalResponse, error) {
+	l, err := a.EnvPool.GetLabByTag(req.LabTag)
+	if err != nil {
+		log.Error().Str("labTag", req.LabTag).Err(err).Msg("error getting lab by tag")
+		return nil, err
+	}
+
+	l.M.RLock()
+	_, err = l.TerminalContainer()
+	l.M.RUnlock()
+	if err != nil {
+		return nil, err
+	}
+
+	expiry := defaultTerminalExpiry
+	if req.ExpiryMinutes > 0 {
+		expiry = time.Duration(req.ExpiryMinutes) * time.Minute
+	}
+	if expiry > maxTerminalExpiry {
+		expiry = maxTerminalExpiry
+	}
+	expiresAt := time.Now().Add(expiry)
+
+	token, err := a.newLabToken(l.Tag, expiresAt)
+	if err != nil {
+		log.Error().Err(err).Str("labTag", l.Tag).Msg("error signing lab token")
+		return nil, err
+	}
+
+	envTag := strings.Split(l.Tag, "-")[0]
+	return &proto.TerminalResponse{
+		TerminalUrl: a.proxyURL(envTag, "/terminal") + "?token=" + token,
+		ExpiresAt:   expiresAt.Unix(),
+	}, nil
+}
+
+// Serves the xterm.js page for the terminal
+func (a *Agent) terminal(c *gin.Context) {
+	envTag := a.envTagFromRequest(c)
+	if _, err := a.labFromTerminalToken(c.Query("token"), envTag); err != nil {
+		c.JSON(http.StatusUnauthorized, ProxyResponse{Message: err.Error()})
+		return
+	}
+
+	c.HTML(http.StatusOK, "terminal.html", gin.H{
+		"wsPath": strings.TrimSuffix(c.Request.URL.Path, "/") + "/ws",
+	})
+}
+
+// Upgrades the request to a websocket and streams a TTY in the terminal container of the lab to and from the browser
+func (a *Agent) terminalWebsocket(c *gin.Context) {
+	envTag := a.envTagFromRequest(c)
+	labTag, err := a.labFromTerminalToken(c.Query("token"), envTag)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, ProxyResponse{Message: err.Error()})
+		return
+	}
+
+	l, err := a.EnvPool.GetLabByTag(labTag)
+	if err != nil {
+		c.JSON(http.StatusNotFound, ProxyResponse{Message: "lab not found"})
+		return
+	}
+
+	l.M.RLock()
+	container, err := l.TerminalContainer()
+	l.M.RUnlock()
+	if err != nil {
+		c.JSON(http.StatusNotFound, ProxyResponse{Message: err.Error()})
+		return
+	}
+
+	conn, err := terminalUpgrader.Upgrade(c.Writer, c.Request, nil)
+	if err != nil {
+		log.Error().Err(err).Str("labTag", labTag).Msg("error upgrading terminal connection")
+		return
+	}
+	defer conn.Close()
+
+	ctx, cancel := context.WithCancel(c.Request.Context())
+	defer cancel()
+
+	stdin, stdinWriter := io.Pipe()
+	resize := make(chan virtual.TTYSize, 1)
+	go func() {
+		defer cancel()
+		defer stdinWriter.Close()
+		for {
+			_, data, err := conn.ReadMessage()
+			if err != nil {
+				return
+			}
+			var msg terminalMessage
+			if err := json.Unmarshal(data, &msg); err != nil {
+				continue
+			}
+			switch msg.Type {
+			case "input":
+				if _, err := stdinWriter.Write([]byte(msg.Data)); err != nil {
+					return
+				}
+			case "resize":
+				if msg.Cols == 0 || msg.Rows == 0 {
+					continue
+				}
+				select {
+				case resize <- virtual.TTYSize{Cols: msg.Cols, Rows: msg.Rows}:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}
+	}()
+
+	log.Info().Str("labTag", labTag).Msg("opening terminal in container")
+	if err := container.ExecTTY(ctx, terminalCmd, stdin, &terminalWriter{conn: conn}, resize); err != nil && !errors.Is(err, context.Canceled) {
+		log.Error().Err(err).Str("labTag", labTag).Msg("error running terminal in container")
+	}
+	conn.WriteMessage(websocket.CloseMessage, websocket.FormatCloseMessage(websocket.CloseNormalClosure, ""))
+}
+
+// Signs a token which gives access to the terminal of a lab until it expires
+func (a *Agent) newLabToken(labTag string, expiresAt time.Time) (string, error) {
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{
+		LAB_KEY: labTag,
+		"aud":   terminalAudience,
+		"exp":   expiresAt.Unix(),
+	})
+	return token.SignedString([]byte(a.config.SignKey))
+}
+
+// Validates a lab token and returns the tag of the lab it gives access to. Tokens are verified with the
+// sign keys like gRPC tokens, so lab tokens keep working while keys are rotated.
+// The lab must belong to the environment the request was routed to.
+func (a *Agent) labFromTerminalToken(token, envTag string) (string, error) {
+	if token == "" {
+		return "", MissingKeyErr
+	}
+
+	jwtToken, err := jwt.Parse(token, signingKeyFunc(a.config.SignKey, a.config.SignKeys))
+	if err != nil {
+		return "", err
+	}
+
+	claims, ok := jwtToken.Claims.(jwt.MapClaims)
+	if !ok || !jwtToken.Valid || !claims.VerifyExpiresAt(time.Now().Unix(), true) || !claims.VerifyAudience(terminalAudience, true) {
+		return "", InvalidTokenFormatErr
+	}
+
+	labTag, ok := claims[LAB_KEY].(string)
+	if !ok || strings.Split(labTag, "-")[0] != envTag {
+		return "", InvalidTokenFormatErr
+	}
+
+	return labTag, nil
+}
@@ -0,0 +1,222 @@
+package agent
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/rs/zerolog/log"
+)
+
+const certReloadInterval = 30 * time.Second
+
+// Keeps the certificate of the agent in memory and reloads it when the certificate or key file changes,
+// so renewed certificates are used without restarting the agent.
+type certReloader struct {
+	certFile string
+	keyFile  string
+
+	m       sync.RWMutex
+	cert    *tls.Certificate
+	certMod time.Time
+	keyMod  time.Time
+}
+
+func newCertReloader(certFile, keyFile string) (*certReloader, error) {
+	cr := &certReloader{
+		certFile: certFile,
+		keyFile:  keyFile,
+	}
+	if err := cr.reload(); err != nil {
+		return nil, err
+	}
+	return cr, nil
+}
+
+func (cr *certReloader) reload() error {
+	certInfo, err := os.Stat(cr.certFile)
+	if err != nil {
+		return err
+	}
+	keyInfo, err := os.Stat(cr.keyFile)
+	if err != nil {
+		return err
+	}
+
+	cert, err := tls.LoadX509KeyPair(cr.certFile, cr.keyFile)
+	if err != nil {
+		return fmt.Errorf("error loading certificate: %w", err)
+	}
+
+	cr.m.Lock()
+	defer cr.m.Unlock()
+	cr.cert = &cert
+	cr.certMod = certInfo.ModTime()
+	cr.keyMod = keyInfo.ModTime()
+	return nil
+}
+
+// Returns true if the certificate or key file has been modified since it was loaded
+func (cr *certReloader) changed() bool {
+	certInfo, err := os.Stat(cr.certFile)
+	if err != nil {
+		return false
+	}
+	keyInfo, err := os.Stat(cr.keyFile)
+	if err != nil {
+		return false
+	}
+
+	cr.m.RLock()
+	defer cr.m.RUnlock()
+	return !certInfo.ModTime().Equal(cr.certMod) || !keyInfo.ModTime().Equal(cr.keyMod)
+}
+
+// Periodically checks the certificate and key files for changes and reloads them
+func (cr *certReloader) watch(interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for range ticker.C {
+		if !cr.changed() {
+			continue
+		}
+		// Certificate and key might not be written at the same time, so keep the old one until the pair is valid
+		if err := cr.reload(); err != nil {
+			log.Warn().Err(err).Str("certFile", cr.certFile).Msg("error reloading certificate, keeping current certificate")
+			continue
+		}
+		log.Info().Str("certFile", cr.certFile).Msg("reloaded certificate")
+	}
+}
+
+func (cr *certReloader) GetCertificate(*tls.ClientHelloInfo) (*tls.Certificate, error) {
+	cr.m.RLock()
+	defer cr.m.RUnlock()
+	return cr.cert, nil
+}
+
+// Creates the TLS config used by the gRPC server. If a CA is configured, client certificates from the daemon
+// are verified against it, and if required, connections without a valid client certificate are rejected.
+// The CA is reloaded like the certificate, so a renewed CA is used without restarting the agent.
+func newTLSConfig(conf TLSConf) (*tls.Config, error) {
+	reloader, err := newCertReloader(conf.CertFile, conf.KeyFile)
+	if err != nil {
+		return nil, err
+	}
+	go reloader.watch(certReloadInterval)
+
+	tlsConfig := &tls.Config{
+		MinVersion:     tls.VersionTLS12,
+		GetCertificate: reloader.GetCertificate,
+	}
+
+	if conf.CAFile != "" {
+		caReloader, err := newCAReloader(conf.CAFile)
+		if err != nil {
+			return nil, err
+		}
+		go caReloader.watch(certReloadInterval)
+
+		clientAuth := tls.VerifyClientCertIfGiven
+		if conf.RequireClientCert {
+			clientAuth = tls.RequireAndVerifyClientCert
+		}
+		// The config is copied for every connection, so connections verify client certificates with the current CA
+		tlsConfig.ClientAuth = clientAuth
+		tlsConfig.GetConfigForClient = func(*tls.ClientHelloInfo) (*tls.Config, error) {
+			config := tlsConfig.Clone()
+			config.GetConfigForClient = nil
+			config.ClientCAs = caReloader.pool()
+			return config, nil
+		}
+	}
+
+	return tlsConfig, nil
+}
+
+// Keeps the CA pool which client certificates are verified against, and reloads it when the CA file changes
+type caReloader struct {
+	caFile string
+
+	m     sync.RWMutex
+	certs *x509.CertPool
+	mod   time.Time
+}
+
+func newCAReloader(caFile string) (*caReloader, error) {
+	cr := &caReloader{caFile: caFile}
+	if err := cr.reload(); err != nil {
+		return nil, err
+	}
+	return cr, nil
+}
+
+func (cr *caReloader) reload() error {
+	info, err := os.Stat(cr.caFile)
+	if err != nil {
+		return err
+	}
+	ca, err := os.ReadFile(cr.caFile)
+	if err != nil {
+		return fmt.Errorf("error reading ca file: %w", err)
+	}
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(ca) {
+		return errors.New("no certificates found in ca file")
+	}
+
+	cr.m.Lock()
+	defer cr.m.Unlock()
+	cr.certs = pool
+	cr.mod = info.ModTime()
+	return nil
+}
+
+// Periodically checks the CA file for changes and reloads it, keeping the current CA if the new file is invalid
+func (cr *caReloader) watch(interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for range ticker.C {
+		info, err := os.Stat(cr.caFile)
+		if err != nil {
+			continue
+		}
+		cr.m.RLock()
+		changed := !info.ModTime().Equal(cr.mod)
+		cr.m.RUnlock()
+		if !changed {
+			continue
+		}
+		if err := cr.reload(); err != nil {
+			log.Warn().Err(err).Str("caFile", cr.caFile).Msg("error reloading ca, keeping current ca")
+			continue
+		}
+		log.Info().Str("caFile", cr.caFile).Msg("reloaded ca")
+	}
+}
+
+func (cr *caReloader) pool() *x509.CertPool {
+	cr.m.RLock()
+	defer cr.m.RUnlock()
+	return cr.certs
+}
+
+// Returns the TLS config for the gRPC server, or nil if TLS is not enabled
+func (a *Agent) TLSConfig() *tls.Config {
+	return a.tlsConfig
+}
+
+// Browsers connecting to the proxy do not have client certificates, so the proxy only uses the certificate of the agent
+func (a *Agent) proxyTLSConfig() *tls.Config {
+	if a.tlsConfig == nil {
+		return nil
+	}
+	return &tls.Config{
+		MinVersion:     tls.VersionTLS12,
+		GetCertificate: a.tlsConfig.GetCertificate,
+	}
+}
@@ -0,0 +1,85 @@
+package agent
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aau-network-security/haaukins-agent/internal/tracing"
+	"github.com/rs/zerolog/log"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	TracingExporterOTLP = "otlp"
+	TracingExporterFile = "file"
+)
+
+// Sets up tracing with the exporter from the config
+func setupTracing(conf TracingConf, version string) error {
+	switch conf.Exporter {
+	case TracingExporterOTLP:
+		exporter, err := tracing.NewOTLPExporter(context.Background(), conf.Endpoint, conf.Insecure, nil)
+		if err != nil {
+			return fmt.Errorf("error creating otlp exporter: %w", err)
+		}
+		tracing.Setup(exporter, conf.SampleRatio, version)
+		log.Info().Str("endpoint", conf.Endpoint).Float64("sampleRatio", conf.SampleRatio).Msg("exporting traces to otlp collector")
+	case TracingExporterFile:
+		exporter, err := tracing.NewFileExporter(conf.File)
+		if err != nil {
+			return fmt.Errorf("error opening trace file: %w", err)
+		}
+		tracing.Setup(exporter, conf.SampleRatio, version)
+		log.Info().Str("file", conf.File).Float64("sampleRatio", conf.SampleRatio).Msg("exporting traces to file")
+	}
+	return nil
+}
+
+// Starts a span for every call, continuing the trace from the metadata of the call if the daemon sent one.
+// The span is started before the call is authenticated, so rejected calls are traced as well.
+func (a *Agent) tracingUnaryInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	ctx, span := startCallSpan(ctx, info.FullMethod, req)
+	resp, err := handler(ctx, req)
+	endCallSpan(span, err)
+	return resp, err
+}
+
+func (a *Agent) tracingStreamInterceptor(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	ctx, span := startCallSpan(stream.Context(), info.FullMethod, nil)
+	err := handler(srv, &contextStream{ServerStream: stream, ctx: ctx})
+	endCallSpan(span, err)
+	return err
+}
+
+func startCallSpan(ctx context.Context, fullMethod string, req interface{}) (context.Context, trace.Span) {
+	ctx = tracing.Extract(ctx)
+	ctx, span := tracing.StartServer(ctx, fullMethod,
+		attribute.String("rpc.system", "grpc"),
+		attribute.String("rpc.method", fullMethod),
+	)
+	if r, ok := req.(interface{ GetEventTag() string }); ok && r.GetEventTag() != "" {
+		span.SetAttributes(tracing.EventTag(r.GetEventTag()))
+	}
+	if r, ok := req.(interface{ GetLabTag() string }); ok && r.GetLabTag() != "" {
+		span.SetAttributes(tracing.LabTag(r.GetLabTag()))
+	}
+	return ctx, span
+}
+
+func endCallSpan(span trace.Span, err error) {
+	span.SetAttributes(attribute.String("rpc.grpc.status_code", status.Code(err).String()))
+	tracing.End(span, err)
+}
+
+// Server stream with a context set by an interceptor
+type contextStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *contextStream) Context() context.Context {
+	return s.ctx
+}
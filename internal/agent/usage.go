@@ -0,0 +1,322 @@
+package agent
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/aau-network-security/haaukins-agent/internal/environment/lab"
+	"github.com/aau-network-security/haaukins-agent/internal/environment/lab/virtual"
+	"github.com/aau-network-security/haaukins-agent/pkg/proto"
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	// Labs sampled at the same time, each lab samples its machines concurrently as well
+	maxConcurrentLabSamples = 10
+	labSampleTimeout        = 20 * time.Second
+
+	usageFile = "usage.json"
+	gib       = 1 << 30
+)
+
+// Latest resource usage of the labs and the cumulative usage of each event. Labs are sampled in the background,
+// so the monitoring stream does not have to wait for docker and VirtualBox.
+// Totals are saved in the state path with the last counters of each machine, so they are kept across restarts
+// and after events are closed for reporting, and machines still running after a restart are not counted twice.
+type labUsageStore struct {
+	m        sync.RWMutex
+	path     string
+	usage    map[string]labUsage
+	machines map[string]machineCounters
+	totals   map[string]*eventTotals
+}
+
+// Saved usage, totals by event tag and counters by machine id
+type savedUsage struct {
+	Events   map[string]*eventTotals    `json:"events"`
+	Machines map[string]machineCounters `json:"machines"`
+}
+
+// Counters of a machine when it was last sampled, which the growth of the next sample is counted from
+type machineCounters struct {
+	LabTag          string `json:"labTag"`
+	NetRxBytes      uint64 `json:"netRxBytes"`
+	NetTxBytes      uint64 `json:"netTxBytes"`
+	BlockReadBytes  uint64 `json:"blockReadBytes"`
+	BlockWriteBytes uint64 `json:"blockWriteBytes"`
+}
+
+type labUsage struct {
+	lab.Usage
+	EventTag  string
+	SampledAt time.Time
+}
+
+// Cumulative usage of an event. CPU and memory are integrated over the time between samples.
+// Network and block IO are summed from the growth of the counters of each machine.
+type eventTotals struct {
+	CPUCoreSeconds    float64   `json:"cpuCoreSeconds"`
+	MemoryByteSeconds float64   `json:"memoryByteSeconds"`
+	NetRxBytes        uint64    `json:"netRxBytes"`
+	NetTxBytes        uint64    `json:"netTxBytes"`
+	BlockReadBytes    uint64    `json:"blockReadBytes"`
+	BlockWriteBytes   uint64    `json:"blockWriteBytes"`
+	Since             time.Time `json:"since"`
+	UpdatedAt         time.Time `json:"updatedAt"`
+}
+
+func newLabUsageStore(statePath string) *labUsageStore {
+	s := &labUsageStore{
+		path:     filepath.Join(statePath, usageFile),
+		usage:    make(map[string]labUsage),
+		machines: make(map[string]machineCounters),
+		totals:   make(map[string]*eventTotals),
+	}
+
+	data, err := os.ReadFile(s.path)
+	if err != nil {
+		if !errors.Is(err, os.ErrNotExist) {
+			log.Error().Err(err).Str("path", s.path).Msg("error reading usage totals")
+		}
+		return s
+	}
+	var saved savedUsage
+	if err := json.Unmarshal(data, &saved); err != nil {
+		log.Error().Err(err).Str("path", s.path).Msg("error unmarshalling usage totals")
+		return s
+	}
+	if saved.Events != nil {
+		s.totals = saved.Events
+	}
+	if saved.Machines != nil {
+		s.machines = saved.Machines
+	}
+	return s
+}
+
+// Replaces the usage of the labs with a new sample and adds the usage since the previous sample to the totals.
+// Machines of running labs which could not be sampled keep their previous counters, so their usage is not counted
+// twice when they are sampled again. Counters of machines in labs which are gone are forgotten.
+func (s *labUsageStore) update(usage map[string]labUsage) {
+	s.m.Lock()
+	defer s.m.Unlock()
+
+	machines := make(map[string]machineCounters)
+	for id, mc := range s.machines {
+		if _, ok := usage[mc.LabTag]; ok {
+			machines[id] = mc
+		}
+	}
+	for tag, u := range usage {
+		totals, ok := s.totals[u.EventTag]
+		if !ok {
+			totals = &eventTotals{Since: u.SampledAt}
+			s.totals[u.EventTag] = totals
+		}
+		totals.UpdatedAt = u.SampledAt
+
+		if prev, ok := s.usage[tag]; ok {
+			elapsed := u.SampledAt.Sub(prev.SampledAt).Seconds()
+			totals.CPUCoreSeconds += u.CPUPercent / 100 * elapsed
+			totals.MemoryByteSeconds += float64(u.MemoryBytes) * elapsed
+		}
+
+		for id, mu := range u.Machines {
+			prev := s.machines[id]
+			totals.NetRxBytes += counterGrowth(prev.NetRxBytes, mu.NetRxBytes)
+			totals.NetTxBytes += counterGrowth(prev.NetTxBytes, mu.NetTxBytes)
+			totals.BlockReadBytes += counterGrowth(prev.BlockReadBytes, mu.BlockReadBytes)
+			totals.BlockWriteBytes += counterGrowth(prev.BlockWriteBytes, mu.BlockWriteBytes)
+			machines[id] = newMachineCounters(tag, mu)
+		}
+	}
+
+	// Closed labs are forgotten by replacing the usage
+	s.usage = usage
+	s.machines = machines
+
+	data, err := json.Marshal(savedUsage{Events: s.totals, Machines: s.machines})
+	if err != nil {
+		log.Error().Err(err).Msg("error marshalling usage totals")
+		return
+	}
+	if err := os.WriteFile(s.path, data, 0644); err != nil {
+		log.Error().Err(err).Str("path", s.path).Msg("error saving usage totals")
+	}
+}
+
+func newMachineCounters(labTag string, u virtual.Usage) machineCounters {
+	return machineCounters{
+		LabTag:          labTag,
+		NetRxBytes:      u.NetRxBytes,
+		NetTxBytes:      u.NetTxBytes,
+		BlockReadBytes:  u.BlockReadBytes,
+		BlockWriteBytes: u.BlockWriteBytes,
+	}
+}
+
+// Returns how much a counter has grown since it was last seen. A counter lower than before
+// belongs to a machine which has been restarted, so all of it is new.
+func counterGrowth(prev, cur uint64) uint64 {
+	if cur < prev {
+		return cur
+	}
+	return cur - prev
+}
+
+// Returns the latest usage of the labs, sorted by lab tag. Labs are filtered by event and lab tag if they are not empty.
+func (s *labUsageStore) protoLabUsage(eventTag, labTag string) []*proto.LabUsage {
+	s.m.RLock()
+	defer s.m.RUnlock()
+
+	var usage []*proto.LabUsage
+	for tag, u := range s.usage {
+		if (eventTag != "" && u.EventTag != eventTag) || (labTag != "" && tag != labTag) {
+			continue
+		}
+		usage = append(usage, &proto.LabUsage{
+			LabTag:          tag,
+			EventTag:        u.EventTag,
+			CpuPercent:      u.CPUPercent,
+			MemoryBytes:     u.MemoryBytes,
+			Containers:      uint32(u.Containers),
+			Vms:             uint32(u.Vms),
+			SampledAt:       u.SampledAt.Unix(),
+			NetRxBytes:      u.NetRxBytes,
+			NetTxBytes:      u.NetTxBytes,
+			BlockReadBytes:  u.BlockReadBytes,
+			BlockWriteBytes: u.BlockWriteBytes,
+		})
+	}
+	sort.Slice(usage, func(i, j int) bool { return usage[i].LabTag < usage[j].LabTag })
+	return usage
+}
+
+// Returns the current usage and totals of the events, sorted by event tag.
+// Closed events are included with their totals, unless a specific event is requested.
+func (s *labUsageStore) protoEventUsage(eventTag string) []*proto.EventUsage {
+	s.m.RLock()
+	defer s.m.RUnlock()
+
+	events := make(map[string]*proto.EventUsage)
+	event := func(tag string) *proto.EventUsage {
+		e, ok := events[tag]
+		if !ok {
+			e = &proto.EventUsage{EventTag: tag}
+			events[tag] = e
+		}
+		return e
+	}
+
+	for _, u := range s.usage {
+		if eventTag != "" && u.EventTag != eventTag {
+			continue
+		}
+		e := event(u.EventTag)
+		e.Labs++
+		e.CpuPercent += u.CPUPercent
+		e.MemoryBytes += u.MemoryBytes
+		e.NetRxBytes += u.NetRxBytes
+		e.NetTxBytes += u.NetTxBytes
+		e.BlockReadBytes += u.BlockReadBytes
+		e.BlockWriteBytes += u.BlockWriteBytes
+	}
+
+	for tag, t := range s.totals {
+		if eventTag != "" && tag != eventTag {
+			continue
+		}
+		event(tag).Totals = &proto.UsageTotals{
+			CpuHours:        t.CPUCoreSeconds / 3600,
+			MemoryGbHours:   t.MemoryByteSeconds / gib / 3600,
+			NetRxBytes:      t.NetRxBytes,
+			NetTxBytes:      t.NetTxBytes,
+			BlockReadBytes:  t.BlockReadBytes,
+			BlockWriteBytes: t.BlockWriteBytes,
+			Since:           t.Since.Unix(),
+			UpdatedAt:       t.UpdatedAt.Unix(),
+		}
+	}
+
+	var usage []*proto.EventUsage
+	for _, e := range events {
+		usage = append(usage, e)
+	}
+	sort.Slice(usage, func(i, j int) bool { return usage[i].EventTag < usage[j].EventTag })
+	return usage
+}
+
+// Returns the latest resource usage of labs and events, and the cumulative usage of events for reporting.
+// Usage is sampled every lab usage interval, so it may be up to one interval old.
+func (a *Agent) GetLabUsage(ctx context.Context, req *proto.LabUsageRequest) (*proto.LabUsageResponse, error) {
+	resp := &proto.LabUsageResponse{
+		Labs: a.labUsage.protoLabUsage(req.EventTag, req.LabTag),
+	}
+	if req.LabTag == "" {
+		resp.Events = a.labUsage.protoEventUsage(req.EventTag)
+	}
+	return resp, nil
+}
+
+// Samples the resource usage of every lab every interval
+func (a *Agent) sampleLabUsage(interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for range ticker.C {
+		a.sampleLabUsageOnce()
+	}
+}
+
+func (a *Agent) sampleLabUsageOnce() {
+	type envLab struct {
+		eventTag string
+		lab      *lab.Lab
+	}
+	var labs []envLab
+	a.EnvPool.M.RLock()
+	for tag, env := range a.EnvPool.Envs {
+		env.M.RLock()
+		for _, l := range env.Labs {
+			labs = append(labs, envLab{eventTag: tag, lab: l})
+		}
+		env.M.RUnlock()
+	}
+	a.EnvPool.M.RUnlock()
+
+	var (
+		m     sync.Mutex
+		wg    sync.WaitGroup
+		usage = make(map[string]labUsage)
+		sem   = make(chan struct{}, maxConcurrentLabSamples)
+	)
+	for _, el := range labs {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(el envLab) {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+			ctx, cancel := context.WithTimeout(context.Background(), labSampleTimeout)
+			defer cancel()
+
+			u, err := el.lab.Usage(ctx)
+			if err != nil {
+				log.Debug().Err(err).Str("labTag", el.lab.Tag).Msg("error sampling usage of some machines in lab")
+			}
+
+			m.Lock()
+			usage[el.lab.Tag] = labUsage{Usage: u, EventTag: el.eventTag, SampledAt: time.Now()}
+			m.Unlock()
+		}(el)
+	}
+	wg.Wait()
+
+	a.labUsage.update(usage)
+}
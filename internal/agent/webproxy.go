@@ -0,0 +1,76 @@
+package agent
+
+import (
+	"net"
+	"net/http"
+	"net/http/httputil"
+	"strings"
+
+	"github.com/aau-network-security/haaukins-agent/internal/environment/lab"
+	"github.com/aau-network-security/haaukins-agent/pkg/proto"
+	"github.com/gin-gonic/gin"
+	"github.com/rs/zerolog/log"
+)
+
+// Proxies requests for hosts like "<token>.<eventTag>.<host>" to the exposed exercise web service with that token,
+// so web challenges can be solved from the browser without a frontend or VPN. Any other request is passed on to the routes of the proxy.
+func (a *Agent) exposedServiceProxy(c *gin.Context) {
+	token, envTag, ok := a.exposedServiceHost(c.Request.Host)
+	if !ok {
+		c.Next()
+		return
+	}
+	defer c.Abort()
+
+	service, err := a.EnvPool.GetExposedService(envTag, token)
+	if err != nil {
+		c.JSON(http.StatusNotFound, ProxyResponse{Message: "service not found"})
+		return
+	}
+
+	proxy := &httputil.ReverseProxy{
+		Director: func(req *http.Request) {
+			req.Header.Add("X-Forwarded-Host", req.Host)
+			req.URL.Scheme = "http"
+			req.URL.Host = service.Address
+			// Services expect to be requested by their record name like on the lab network
+			req.Host = service.Name
+		},
+		ErrorHandler: func(w http.ResponseWriter, req *http.Request, err error) {
+			log.Debug().Err(err).Str("service", service.Name).Msg("error proxying request to exposed service")
+			w.WriteHeader(http.StatusBadGateway)
+		},
+	}
+	proxy.ServeHTTP(c.Writer, c.Request)
+}
+
+// Splits a request host into the token and event tag of an exposed service if it has the form "<token>.<eventTag>.<host>"
+func (a *Agent) exposedServiceHost(host string) (string, string, bool) {
+	if h, _, err := net.SplitHostPort(host); err == nil {
+		host = h
+	}
+
+	prefix := strings.TrimSuffix(host, "."+a.config.Host)
+	if prefix == host {
+		return "", "", false
+	}
+
+	labels := strings.Split(prefix, ".")
+	if len(labels) != 2 || labels[0] == "" || labels[1] == "" {
+		return "", "", false
+	}
+	return labels[0], labels[1], true
+}
+
+// Returns the URLs of the exposed web services in a lab to be sent to the daemon
+func (a *Agent) protoExposedServices(l *lab.Lab, envTag string) []*proto.ExposedService {
+	var services []*proto.ExposedService
+	for _, s := range l.ExposedServices {
+		services = append(services, &proto.ExposedService{
+			ExerciseTag: s.ExerciseTag,
+			Name:        s.Name,
+			Url:         "//" + s.Token + "." + envTag + "." + a.config.Host + "/",
+		})
+	}
+	return services
+}
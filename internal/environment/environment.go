@@ -4,7 +4,6 @@ import (
 	"context"
 	"errors"
 	"fmt"
-	"io"
 	"math/rand"
 	"net"
 	"os"
@@ -18,6 +17,7 @@ import (
 	"github.com/aau-network-security/haaukins-agent/internal/environment/lab"
 	wg "github.com/aau-network-security/haaukins-agent/internal/environment/lab/network/vpn"
 	"github.com/aau-network-security/haaukins-agent/internal/environment/lab/virtual"
+	"github.com/aau-network-security/haaukins-agent/internal/logging"
 	"github.com/rs/zerolog/log"
 )
 
@@ -30,18 +30,19 @@ func (ec *EnvConfig) NewEnv(ctx context.Context) (*Environment, error) {
 	// Make worker work
 	guac, err := NewGuac(ctx, ec.Tag)
 	if err != nil {
-		log.Error().Err(err).Msg("error creating new guacamole")
+		logging.Ctx(ctx).Error().Err(err).Msg("error creating new guacamole")
 		return nil, err
 	}
 	// Getting wireguard client from config
 	wgClient, err := wg.NewGRPCVPNClient(ec.VpnConfig)
 	if err != nil {
-		log.Error().Err(err).Msg("error connecting to wg server")
+		logging.Ctx(ctx).Error().Err(err).Msg("error connecting to wg server")
 		guac.Close()
 		return nil, err
 	}
 
 	ipT := IPTables{
+		EventTag: ec.Tag,
 		Sudo:     true,
 		ExecFunc: ShellExec,
 	}
@@ -65,6 +66,7 @@ func (ec *EnvConfig) NewEnv(ctx context.Context) (*Environment, error) {
 		Guac:          guac,
 		IpAddrs:       eventVPNIPs,
 		Labs:          map[string]*lab.Lab{},
+		Spectators:    map[string]*Spectator{},
 		GuacUserStore: NewGuacUserStore(),
 		Wg:            wgClient,
 		Dockerhost:    dockerHost,
@@ -81,7 +83,7 @@ func (env *Environment) Start(ctx context.Context) error {
 	for _, f := range env.EnvConfig.LabConf.Frontends {
 		frontendNames = append(frontendNames, f.Image)
 	}
-	log.Info().
+	logging.Ctx(ctx).Info().
 		Str("Tag", env.EnvConfig.Tag).
 		Strs("Frontends", frontendNames).
 		Msg("starting environment")
@@ -94,7 +96,7 @@ func (env *Environment) Start(ctx context.Context) error {
 	env.EnvConfig.VPNEndpointPort = port
 
 	// Initializing wireguard for the port
-	log.Info().Int("port", port).Msg("initializing VPN endpoinrt on port")
+	logging.Ctx(ctx).Info().Int("port", port).Msg("initializing VPN endpoinrt on port")
 	_, err := env.Wg.InitializeI(context.Background(), &wgproto.IReq{
 		Address:    env.EnvConfig.VPNAddress,
 		ListenPort: uint32(port),
@@ -105,7 +107,7 @@ func (env *Environment) Start(ctx context.Context) error {
 	if err != nil {
 		// Continue without vpn if err is present
 		// TODO If vpn is for some reason not initialized, it should be possible to try to reininialize for this specific agent and environment
-		log.Error().Err(err).Msg("error initializing vpn endpoint")
+		logging.Ctx(ctx).Error().Err(err).Msg("error initializing vpn endpoint")
 		return errors.New("error initializing vpn endpoint")
 	}
 
@@ -117,26 +119,26 @@ func (env *Environment) RemoveVpnLabPeers(ctx context.Context, labTag string) er
 	env.M.Lock()
 	defer env.M.Unlock()
 
-	log.Debug().Msgf("removing ip table rules for lab: %s", labTag)
+	logging.Ctx(ctx).Debug().Msgf("removing ip table rules for lab: %s", labTag)
 	labIpRules, ok := env.IpRules[labTag]
 	if !ok {
-		log.Error().Msg("error removing VPN peers for lab")
+		logging.Ctx(ctx).Error().Msg("error removing VPN peers for lab")
 	}
 	env.IpT.RemoveRejectRule(labIpRules.Labsubnet)
 	env.IpT.RemoveStateRule(labIpRules.Labsubnet)
 	env.IpT.RemoveAcceptRule(labIpRules.Labsubnet, labIpRules.VpnIps)
 	delete(env.IpRules, labTag)
 
-	log.Debug().Msgf("removing wg peers for lab: %s", labTag)
+	logging.Ctx(ctx).Debug().Msgf("removing wg peers for lab: %s", labTag)
 	vpnIps := strings.Split(labIpRules.VpnIps, ",")
 	// Subnet is the last ip and we only want to remove the peers
 	for i := 0; i < len(vpnIps)-1; i++ {
 		ipBytes := strings.Split(vpnIps[i], ".")
 		lastByteStr := strings.Split(ipBytes[3], "/")[0]
-		log.Debug().Msgf("ipBytes: %v", ipBytes)
+		logging.Ctx(ctx).Debug().Msgf("ipBytes: %v", ipBytes)
 		pubKeyResp, err := env.Wg.GetPublicKey(ctx, &wgproto.PubKeyReq{PubKeyName: env.EnvConfig.Tag + "_" + labTag + "_" + lastByteStr})
 		if err != nil {
-			log.Error().Err(err).Msgf("error getting public key for lab: %s", labTag)
+			logging.Ctx(ctx).Error().Err(err).Msgf("error getting public key for lab: %s", labTag)
 			return err
 		}
 		wgReq := wgproto.DelPReq{
@@ -145,11 +147,11 @@ func (env *Environment) RemoveVpnLabPeers(ctx context.Context, labTag string) er
 		}
 		resp, err := env.Wg.DelPeer(ctx, &wgReq)
 		if err != nil {
-			log.Error().Err(err).Msgf("error deleting peer in wireguard for lab: %s", labTag)
+			logging.Ctx(ctx).Error().Err(err).Msgf("error deleting peer in wireguard for lab: %s", labTag)
 			return err
 		}
 		if resp != nil {
-			log.Debug().Str("response", resp.Message).Msgf("resp from wg when deleting peer for lab: %s", labTag)
+			logging.Ctx(ctx).Debug().Str("response", resp.Message).Msgf("resp from wg when deleting peer for lab: %s", labTag)
 		}
 		// Index byte is determined by the second last byte of the ip - 240
 		indexByte, _ := strconv.Atoi(ipBytes[2])
@@ -157,14 +159,14 @@ func (env *Environment) RemoveVpnLabPeers(ctx context.Context, labTag string) er
 		env.IpAddrs[indexByte-240] = append(env.IpAddrs[indexByte-240], lastByte)
 	}
 	if err := removeVPNConfigs(env.EnvConfig.VpnConfig.Dir + "/" + env.EnvConfig.Tag + "_" + labTag + "*"); err != nil {
-		log.Error().Err(err).Msgf("Error happened on deleting VPN configuration files for lab %s", labTag)
+		logging.Ctx(ctx).Error().Err(err).Msgf("Error happened on deleting VPN configuration files for lab %s", labTag)
 	}
 
 	return nil
 }
 
 // Closes environment including removing all related containers, and vpn configs
-func (env *Environment) Close() error {
+func (env *Environment) Close(ctx context.Context) error {
 	env.M.Lock()
 	defer env.M.Unlock()
 
@@ -173,9 +175,9 @@ func (env *Environment) Close() error {
 	var wg sync.WaitGroup
 	for _, l := range env.Labs {
 		wg.Add(1)
-		go func(c io.Closer) {
-			if err := c.Close(); err != nil {
-				log.Warn().Msgf("error while closing event '%s': %s", env.EnvConfig.Tag, err)
+		go func(l *lab.Lab) {
+			if err := l.Close(ctx); err != nil {
+				logging.Ctx(ctx).Warn().Msgf("error while closing event '%s': %s", env.EnvConfig.Tag, err)
 			}
 			defer wg.Done()
 		}(l)
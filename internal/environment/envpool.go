@@ -18,7 +18,7 @@ func (ep *EnvPool) GetEnv(tag string) (*Environment, error) {
 	defer ep.M.RUnlock()
 
 	if _, ok := ep.Envs[tag]; !ok {
-		return nil, fmt.Errorf("could not find environment with tag: %s ", tag)
+		return nil, fmt.Errorf("%w with tag: %s", EnvNotFoundErr, tag)
 	}
 
 	return ep.Envs[tag], nil
@@ -53,7 +53,27 @@ func (ep *EnvPool) GetLabByTag(tag string) (*lab.Lab, error) {
 		}
 		e.M.Unlock()
 	}
-	return nil, fmt.Errorf("could not find lab with tag: %s", tag)
+	return nil, fmt.Errorf("%w with tag: %s", LabNotFoundErr, tag)
+}
+
+// Returns a web service exposed by one of the labs in an environment
+func (ep *EnvPool) GetExposedService(envTag, token string) (lab.ExposedService, error) {
+	env, err := ep.GetEnv(envTag)
+	if err != nil {
+		return lab.ExposedService{}, err
+	}
+
+	env.M.RLock()
+	defer env.M.RUnlock()
+	for _, l := range env.Labs {
+		l.M.RLock()
+		service, ok := l.ExposedService(token)
+		l.M.RUnlock()
+		if ok {
+			return service, nil
+		}
+	}
+	return lab.ExposedService{}, fmt.Errorf("%w in environment: %s", ServiceNotFoundErr, envTag)
 }
 
 func (ep *EnvPool) GetFullLabCount() uint32 {
@@ -68,13 +88,46 @@ func (ep *EnvPool) GetFullLabCount() uint32 {
 	return count
 }
 
+// Amount of resources used by an environment
+type EnvStats struct {
+	Labs       int
+	Exercises  int
+	Containers int
+	Vms        int
+	VpnPeers   int
+}
+
+// Returns the resources used by each environment in the pool
+func (ep *EnvPool) GetEnvStats() map[string]EnvStats {
+	ep.M.RLock()
+	defer ep.M.RUnlock()
+	stats := make(map[string]EnvStats)
+	for tag, env := range ep.Envs {
+		var s EnvStats
+		env.M.RLock()
+		for _, l := range env.Labs {
+			l.M.RLock()
+			containers, vms := l.InstanceCounts()
+			s.Labs++
+			s.Exercises += len(l.Exercises)
+			s.Containers += containers
+			s.Vms += vms
+			s.VpnPeers += len(l.VpnConfs)
+			l.M.RUnlock()
+		}
+		env.M.RUnlock()
+		stats[tag] = s
+	}
+	return stats
+}
+
 // Removes an environment from the environment pool
 func (ep *EnvPool) RemoveEnv(tag string) error {
 	ep.M.Lock()
 	defer ep.M.Unlock()
 
 	if _, ok := ep.Envs[tag]; !ok {
-		return fmt.Errorf("could not find environment with tag: %s ", tag)
+		return fmt.Errorf("%w with tag: %s", EnvNotFoundErr, tag)
 	}
 
 	delete(ep.Envs, tag)
@@ -0,0 +1,11 @@
+package environment
+
+import "errors"
+
+var (
+	EnvNotFoundErr     = errors.New("could not find environment")
+	EnvExistsErr       = errors.New("environment already exists")
+	LabNotFoundErr     = errors.New("could not find lab")
+	ServiceNotFoundErr = errors.New("could not find exposed service")
+	NoFrontendsErr     = errors.New("lab has no frontends")
+)
@@ -16,8 +16,10 @@ import (
 
 	"github.com/aau-network-security/haaukins-agent/internal/environment/lab"
 	"github.com/aau-network-security/haaukins-agent/internal/environment/lab/virtual"
+	"github.com/aau-network-security/haaukins-agent/internal/logging"
+	"github.com/aau-network-security/haaukins-agent/internal/metrics"
+	"github.com/aau-network-security/haaukins-agent/internal/tracing"
 	"github.com/google/uuid"
-	"github.com/rs/zerolog/log"
 )
 
 var (
@@ -47,14 +49,15 @@ func NewGuac(ctx context.Context, eventTag string) (Guacamole, error) {
 	}
 
 	adminPass := uuid.New().String()
-	log.Debug().Str("guacpassword", adminPass).Msg("setting password for guac")
+	logging.Ctx(ctx).Debug().Msg("setting password for guac")
 	guac := Guacamole{
+		EventTag:  eventTag,
 		Client:    client,
 		AdminPass: adminPass,
 	}
 
 	if err := guac.create(ctx, eventTag); err != nil {
-		log.Error().Err(err).Msg("error creating guac containers")
+		logging.Ctx(ctx).Error().Err(err).Msg("error creating guac containers")
 		return Guacamole{}, err
 	}
 	return guac, nil
@@ -65,12 +68,12 @@ Creates the necessary containers for guacamole and configures the instance with
 */
 func (guac *Guacamole) create(ctx context.Context, eventTag string) error {
 	if err := virtual.CreateEventFolder(eventTag); err != nil {
-		log.Warn().Err(err).Msg("error creating event folder, filetransfer may not be available for this event on this agent")
+		logging.Ctx(ctx).Warn().Err(err).Msg("error creating event folder, filetransfer may not be available for this event on this agent")
 	}
 
 	// If user is not specified, filetransfer mount is owned by root, and can therefore not be accessed by vbox vm
 	user := fmt.Sprintf("%d:%d", os.Getuid(), os.Getgid())
-	log.Debug().Str("user", user).Msg("starting guacd")
+	logging.Ctx(ctx).Debug().Str("user", user).Msg("starting guacd")
 
 	containers := map[string]*virtual.Container{}
 
@@ -87,7 +90,6 @@ func (guac *Guacamole) create(ctx context.Context, eventTag string) error {
 	})
 
 	mysqlPass := uuid.New().String()
-	log.Debug().Str("mysqlPass", mysqlPass).Msg("mysql pw for guac")
 	containers["db"] = virtual.NewContainer(virtual.ContainerConfig{
 		Image: "ghcr.io/campfire-security/guac-db:latest",
 		EnvVars: map[string]string{
@@ -152,7 +154,7 @@ func (guac *Guacamole) create(ctx context.Context, eventTag string) error {
 	}
 
 	// Configure guacamole for the environment
-	if err := guac.configureInstance(); err != nil {
+	if err := guac.configureInstance(ctx); err != nil {
 		closeAll()
 		return err
 	}
@@ -170,69 +172,119 @@ func (guac *Guacamole) Close() error {
 }
 
 // Connects VMs in a lab to the corresponding guacamole instance for the environment.
-func (env *Environment) CreateGuacConn(lab lab.Lab) error {
-	enableWallPaper := true
-	enableDrive := true
-	createDrivePath := true
-	// Drive path is the home folder inside the docker guacamole
-	drivePath := "/home/" + lab.GuacUsername
-	rdpPorts := lab.RdpConnPorts()
+// The connections follow the desktop policy of the environment.
+// If the environment has more than one member per team, each member gets their own guacamole user
+// which can only access their own frontend.
+func (env *Environment) CreateGuacConn(ctx context.Context, l *lab.Lab) (err error) {
+	ctx, span := tracing.Start(ctx, "CreateGuacConn", tracing.EventTag(env.EnvConfig.Tag), tracing.LabTag(l.Tag))
+	defer func() { tracing.End(span, err) }()
+
+	rdpPorts := l.RdpConnPorts()
 	if n := len(rdpPorts); n == 0 {
-		log.
+		logging.Ctx(ctx).
 			Debug().
 			Int("amount", n).
 			Msg("Too few RDP connections")
 
-		return errors.New("error too few rdp connections")
+		return NoFrontendsErr
+	}
+
+	hostIp, err := env.Dockerhost.GetDockerHostIP()
+	if err != nil {
+		return err
+	}
+
+	if env.EnvConfig.TeamSize > 1 {
+		l.AssignMembers()
+		for i, m := range l.Members {
+			u := GuacUser{
+				Username: m.GuacUsername,
+				Password: m.GuacPassword,
+			}
+			if err := env.createGuacUserWithConns(ctx, l, u, hostIp, []uint{m.RdpPort}, i+1); err != nil {
+				return err
+			}
+		}
+		return nil
 	}
 
-	log.Debug().Str("username", lab.GuacUsername).Str("password", lab.GuacPassword).Msg("creating guac user with credentials")
 	u := GuacUser{
-		Username: lab.GuacUsername,
-		Password: lab.GuacPassword,
+		Username: l.GuacUsername,
+		Password: l.GuacPassword,
 	}
+	return env.createGuacUserWithConns(ctx, l, u, hostIp, rdpPorts, 1)
+}
+
+// Creates a guacamole user with connections to the frontends on the given ports, and links the frontends to the shared folder of the user.
+// Connections are numbered from firstNum to keep the names of the connections unique within the lab.
+func (env *Environment) createGuacUserWithConns(ctx context.Context, l *lab.Lab, u GuacUser, hostIp string, rdpPorts []uint, firstNum int) error {
+	policy := env.EnvConfig.DesktopPolicy
+	enableWallPaper := !policy.DisableWallpaper
+	enableDrive := !policy.DisableDrive
+	createDrivePath := !policy.DisableDrive
+	disableCopy := policy.DisableCopy
+	disablePaste := policy.DisablePaste
+	disableAudio := policy.DisableAudio
+	width, height, depth := policy.VideoMode()
+	// Drive path is the home folder inside the docker guacamole
+	drivePath := "/home/" + u.Username
 
-	if err := env.Guac.CreateUser(u.Username, u.Password); err != nil {
-		log.
+	logging.Ctx(ctx).Debug().Str("username", u.Username).Msg("creating guac user")
+	if err := env.Guac.CreateUser(ctx, u.Username, u.Password); err != nil {
+		logging.Ctx(ctx).
 			Debug().
 			Str("err", err.Error()).
 			Msg("Unable to create guacamole user")
 		return err
 	}
 
-	hostIp, err := env.Dockerhost.GetDockerHostIP()
-	if err != nil {
-		return err
-	}
-
 	for i, port := range rdpPorts {
-		num := i + 1
-		name := fmt.Sprintf("%s-client%d", lab.GuacUsername, num)
-
-		log.Debug().Uint("port", port).Msg("Creating RDP Connection for lab")
-		if err := env.Guac.CreateRDPConn(CreateRDPConnOpts{
-			Host:            hostIp,
-			Port:            port,
-			Name:            name,
-			GuacUser:        u.Username,
-			Username:        &u.Username,
-			Password:        &u.Password,
-			EnableWallPaper: &enableWallPaper,
-			EnableDrive:     &enableDrive,
-			CreateDrivePath: &createDrivePath,
-			DrivePath:       &drivePath,
-		}); err != nil {
+		num := firstNum + i
+		name := fmt.Sprintf("%s-client%d", u.Username, num)
+
+		opts := CreateRDPConnOpts{
+			Host:             hostIp,
+			Port:             port,
+			Name:             name,
+			GuacUser:         u.Username,
+			Username:         &u.Username,
+			Password:         &u.Password,
+			EnableWallPaper:  &enableWallPaper,
+			EnableDrive:      &enableDrive,
+			CreateDrivePath:  &createDrivePath,
+			DrivePath:        &drivePath,
+			ResolutionWidth:  width,
+			ResolutionHeight: height,
+			ColorDepth:       depth,
+			DisableCopy:      &disableCopy,
+			DisablePaste:     &disablePaste,
+			DisableAudio:     &disableAudio,
+		}
+
+		if env.EnvConfig.RecordSessions {
+			recordingPath := guacRecordingPath(l.Tag)
+			recordingName := recordingNamePrefix + name
+			opts.RecordingPath = &recordingPath
+			opts.RecordingName = &recordingName
+		}
+
+		logging.Ctx(ctx).Debug().Uint("port", port).Msg("Creating RDP Connection for lab")
+		if _, err := env.Guac.CreateRDPConn(ctx, opts); err != nil {
 			return err
 		}
 	}
 
-	instanceInfo := lab.InstanceInfo()
 	// Will not handle error below since this is not a critical function
-	_ = virtual.CreateUserFolder(lab.GuacUsername, env.EnvConfig.Tag)
+	_ = virtual.CreateUserFolder(u.Username, env.EnvConfig.Tag)
 
-	for i := 0; i < len(rdpPorts); i++ {
-		if err := virtual.CreateFolderLink(instanceInfo[i].Id, env.EnvConfig.Tag, lab.GuacUsername); err != nil {
-			log.Error().Err(err).Str("instanceId", instanceInfo[i].Id).Msg("error creating folder link for instance with id")
+	for _, port := range rdpPorts {
+		frontend, ok := l.Frontends[port]
+		if !ok || frontend.Vm == nil {
+			continue
+		}
+		id := frontend.Vm.Info().Id
+		if err := virtual.CreateFolderLink(id, env.EnvConfig.Tag, u.Username); err != nil {
+			logging.Ctx(ctx).Error().Err(err).Str("instanceId", id).Msg("error creating folder link for instance with id")
 		}
 	}
 
@@ -240,7 +292,7 @@ func (env *Environment) CreateGuacConn(lab lab.Lab) error {
 }
 
 // Creates a new user in Apache guacamole which can access a specific set of VMs
-func (guac *Guacamole) CreateUser(username, password string) error {
+func (guac *Guacamole) CreateUser(ctx context.Context, username, password string) error {
 	action := func(t string) (*http.Response, error) {
 		data := createUserInput{
 			Username: username,
@@ -249,7 +301,7 @@ func (guac *Guacamole) CreateUser(username, password string) error {
 		jsonData, _ := json.Marshal(data)
 		endpoint := guac.baseUrl() + "/guacamole/api/session/data/mysql/users?token=" + t
 
-		req, err := http.NewRequest("POST", endpoint, bytes.NewBuffer(jsonData))
+		req, err := http.NewRequestWithContext(ctx, "POST", endpoint, bytes.NewBuffer(jsonData))
 		if err != nil {
 			return nil, err
 		}
@@ -263,25 +315,25 @@ func (guac *Guacamole) CreateUser(username, password string) error {
 		Password string `json:"password"`
 	}
 
-	if err := guac.authAction("create user", action, &output); err != nil {
+	if err := guac.authAction(ctx, "create user", action, &output); err != nil {
 		return err
 	}
 
 	return nil
 }
 
-// Creates the Apache Guacamole RDP connection to a specific vm
-func (guac *Guacamole) CreateRDPConn(opts CreateRDPConnOpts) error {
+// Creates the Apache Guacamole RDP connection to a specific vm and returns the identifier of the connection
+func (guac *Guacamole) CreateRDPConn(ctx context.Context, opts CreateRDPConnOpts) (string, error) {
 	if opts.Host == "" {
-		return errors.New("host is missing")
+		return "", errors.New("host is missing")
 	}
 
 	if opts.Port == 0 {
-		return errors.New("port is missing")
+		return "", errors.New("port is missing")
 	}
 
 	if opts.Name == "" {
-		return errors.New("name is missing")
+		return "", errors.New("name is missing")
 	}
 
 	if opts.ResolutionWidth == 0 || opts.ResolutionHeight == 0 {
@@ -294,13 +346,15 @@ func (guac *Guacamole) CreateRDPConn(opts CreateRDPConnOpts) error {
 	}
 
 	if opts.ColorDepth%8 != 0 || opts.ColorDepth > 32 {
-		return errors.New("colorDepth can take the following values: 8, 16, 24, 32")
+		return "", errors.New("colorDepth can take the following values: 8, 16, 24, 32")
 	}
 
 	if opts.ColorDepth == 0 {
 		opts.ColorDepth = 16
 	}
-	log.Debug().Str("drive-path", *opts.DrivePath).Msg("Drivepath for user is")
+	if opts.DrivePath != nil {
+		logging.Ctx(ctx).Debug().Str("drive-path", *opts.DrivePath).Msg("Drivepath for user is")
+	}
 	conf := createRDPConnConf{
 		Hostname:        &opts.Host,
 		Width:           &opts.ResolutionWidth,
@@ -313,6 +367,18 @@ func (guac *Guacamole) CreateRDPConn(opts CreateRDPConnOpts) error {
 		EnableDrive:     opts.EnableDrive,
 		CreateDrivePath: opts.CreateDrivePath,
 		DrivePath:       opts.DrivePath,
+		ReadOnly:        opts.ReadOnly,
+		DisableCopy:     opts.DisableCopy,
+		DisablePaste:    opts.DisablePaste,
+		DisableAudio:    opts.DisableAudio,
+	}
+
+	// Session recording is only enabled if a recording path has been set for the connection.
+	if opts.RecordingPath != nil {
+		createPath := true
+		conf.RecordingPath = opts.RecordingPath
+		conf.RecordingName = opts.RecordingName
+		conf.CreateRecordingPath = &createPath
 	}
 
 	data := struct {
@@ -336,7 +402,7 @@ func (guac *Guacamole) CreateRDPConn(opts CreateRDPConnOpts) error {
 
 	action := func(t string) (*http.Response, error) {
 		endpoint := guac.baseUrl() + "/guacamole/api/session/data/mysql/connections?token=" + t
-		req, err := http.NewRequest("POST", endpoint, bytes.NewBuffer(jsonData))
+		req, err := http.NewRequestWithContext(ctx, "POST", endpoint, bytes.NewBuffer(jsonData))
 		if err != nil {
 			return nil, err
 		}
@@ -348,11 +414,57 @@ func (guac *Guacamole) CreateRDPConn(opts CreateRDPConnOpts) error {
 	var out struct {
 		Id string `json:"identifier"`
 	}
-	if err := guac.authAction("create rdp connection", action, &out); err != nil {
+	if err := guac.authAction(ctx, "create rdp connection", action, &out); err != nil {
+		return "", err
+	}
+
+	if err := guac.addConnectionToUser(ctx, out.Id, opts.GuacUser); err != nil {
+		return "", err
+	}
+
+	return out.Id, nil
+}
+
+// Deletes a user in Apache Guacamole. Connection permissions for the user are removed with it.
+func (guac *Guacamole) DeleteUser(username string) error {
+	action := func(t string) (*http.Response, error) {
+		endpoint := fmt.Sprintf("%s/guacamole/api/session/data/mysql/users/%s?token=%s",
+			guac.baseUrl(),
+			url.PathEscape(username),
+			t)
+
+		req, err := http.NewRequest("DELETE", endpoint, nil)
+		if err != nil {
+			return nil, err
+		}
+
+		return guac.Client.Do(req)
+	}
+
+	if err := guac.authAction(context.Background(), "delete user", action, nil); err != nil {
 		return err
 	}
 
-	if err := guac.addConnectionToUser(out.Id, opts.GuacUser); err != nil {
+	return nil
+}
+
+// Deletes a connection in Apache Guacamole
+func (guac *Guacamole) DeleteConnection(id string) error {
+	action := func(t string) (*http.Response, error) {
+		endpoint := fmt.Sprintf("%s/guacamole/api/session/data/mysql/connections/%s?token=%s",
+			guac.baseUrl(),
+			url.PathEscape(id),
+			t)
+
+		req, err := http.NewRequest("DELETE", endpoint, nil)
+		if err != nil {
+			return nil, err
+		}
+
+		return guac.Client.Do(req)
+	}
+
+	if err := guac.authAction(context.Background(), "delete connection", action, nil); err != nil {
 		return err
 	}
 
@@ -360,7 +472,7 @@ func (guac *Guacamole) CreateRDPConn(opts CreateRDPConnOpts) error {
 }
 
 // Adds newly created RDP connection to a specific Guacamole user
-func (guac *Guacamole) addConnectionToUser(id string, guacuser string) error {
+func (guac *Guacamole) addConnectionToUser(ctx context.Context, id string, guacuser string) error {
 	data := []struct {
 		Operation string `json:"op"`
 		Path      string `json:"path"`
@@ -379,7 +491,7 @@ func (guac *Guacamole) addConnectionToUser(id string, guacuser string) error {
 			guacuser,
 			t)
 
-		req, err := http.NewRequest("PATCH", endpoint, bytes.NewBuffer(jsonData))
+		req, err := http.NewRequestWithContext(ctx, "PATCH", endpoint, bytes.NewBuffer(jsonData))
 		if err != nil {
 			return nil, err
 		}
@@ -388,7 +500,7 @@ func (guac *Guacamole) addConnectionToUser(id string, guacuser string) error {
 		return guac.Client.Do(req)
 	}
 
-	if err := guac.authAction("add user to connection", action, nil); err != nil {
+	if err := guac.authAction(ctx, "add user to connection", action, nil); err != nil {
 		return err
 	}
 
@@ -397,7 +509,7 @@ func (guac *Guacamole) addConnectionToUser(id string, guacuser string) error {
 
 // Configures a guacamole instance for environment.
 // It simply changes the default password
-func (guac *Guacamole) configureInstance() error {
+func (guac *Guacamole) configureInstance(ctx context.Context) error {
 	temp := &Guacamole{
 		Client:    guac.Client,
 		AdminPass: DefaultAdminPass,
@@ -417,7 +529,7 @@ func (guac *Guacamole) configureInstance() error {
 		return err
 	}
 
-	if err := temp.changeAdminPass(guac.AdminPass); err != nil {
+	if err := temp.changeAdminPass(ctx, guac.AdminPass); err != nil {
 		return err
 	}
 
@@ -475,7 +587,7 @@ func (guac *Guacamole) RawLogin(username, password string) ([]byte, error) {
 	return ioutil.ReadAll(resp.Body)
 }
 
-func (guac *Guacamole) changeAdminPass(newPass string) error {
+func (guac *Guacamole) changeAdminPass(ctx context.Context, newPass string) error {
 	action := func(t string) (*http.Response, error) {
 		data := map[string]string{
 			"newPassword": newPass,
@@ -493,36 +605,13 @@ func (guac *Guacamole) changeAdminPass(newPass string) error {
 		return guac.Client.Do(req)
 	}
 
-	if err := guac.authAction("change admin password", action, nil); err != nil {
+	if err := guac.authAction(ctx, "change admin password", action, nil); err != nil {
 		return err
 	}
 
 	return nil
 }
 
-func (guac *Guacamole) GetPortFromConnectionIdentifier(connectionIdentifier string) (string, error) {
-	action := func(t string) (*http.Response, error) {
-		endpoint := guac.baseUrl() + "/guacamole/api/session/data/mysql/connections/" + connectionIdentifier + "/parameters?token=" + t
-		log.Debug().Str("endpoint", endpoint).Msg("endpoint")
-		req, err := http.NewRequest("GET", endpoint, nil)
-		if err != nil {
-			return nil, err
-		}
-		req.Header.Set("Content-Type", "application/json")
-
-		return guac.Client.Do(req)
-	}
-
-	var resp struct {
-		Port string `json:"port"`
-	}
-	if err := guac.authAction("change admin password", action, &resp); err != nil {
-		return "", err
-	}
-
-	return resp.Port, nil
-}
-
 func (guac *Guacamole) baseUrl() string {
 	return fmt.Sprintf("http://127.0.0.1:%d", guac.Port)
 }
@@ -535,7 +624,11 @@ func isExpectedStatus(s int) error {
 	return fmt.Errorf("unexpected response %d", s)
 }
 
-func (guac *Guacamole) authAction(action string, a func(string) (*http.Response, error), i interface{}) error {
+// Performs an action on the Guacamole API, logging in again if the token has expired. The action is traced as a child of ctx.
+func (guac *Guacamole) authAction(ctx context.Context, action string, a func(string) (*http.Response, error), i interface{}) (err error) {
+	_, span := tracing.Start(ctx, "guac "+action)
+	defer func() { tracing.End(span, err) }()
+
 	perform := func() ([]byte, int, error) {
 		resp, err := a(guac.Token)
 		if err != nil {
@@ -608,6 +701,7 @@ func (guac *Guacamole) authAction(action string, a func(string) (*http.Response,
 	}
 
 	if err != nil {
+		metrics.GuacAPIErrors.WithLabelValues(guac.EventTag, action).Inc()
 		return err
 	}
 
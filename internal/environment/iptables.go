@@ -6,11 +6,13 @@ import (
 	"io"
 	"os/exec"
 
+	"github.com/aau-network-security/haaukins-agent/internal/metrics"
 	"github.com/rs/zerolog/log"
 )
 
 type IPTables struct {
-	Sudo bool
+	EventTag string
+	Sudo     bool
 
 	// Flags to service
 	Flags []string
@@ -126,6 +128,7 @@ func (ipTab *IPTables) exec(cmd string, args ...string) ([]byte, error) {
 		log.Debug().Msgf("exec: %q", string(out))
 	}
 	if err != nil {
+		metrics.IptablesFailures.WithLabelValues(ipTab.EventTag).Inc()
 		// Wrap errors in Error type for further introspection
 		return nil, &Errori{
 			Out: out,
@@ -0,0 +1,14 @@
+package lab
+
+import "errors"
+
+var (
+	NoTerminalContainerErr = errors.New("no container with a terminal running in lab")
+	VmNotFoundErr          = errors.New("no vm running in lab on that port")
+	MemberNotFoundErr      = errors.New("could not find member in lab")
+	MemberRequiredErr      = errors.New("guac username of a member is required in labs with more than one member")
+	NotVPNLabErr           = errors.New("lab is not a VPN lab")
+	VPNLabErr              = errors.New("lab is a VPN lab")
+	VPNConfsExistErr       = errors.New("VPN configs already generated for this lab")
+	InvalidLabTypeErr      = errors.New("not supported for this type of lab")
+)
@@ -2,30 +2,40 @@ package lab
 
 import (
 	"context"
-	"errors"
 	"fmt"
+	"net"
+	"strconv"
 	"strings"
 	"sync"
 
 	"github.com/aau-network-security/haaukins-agent/internal/environment/lab/exercise"
+	"github.com/aau-network-security/haaukins-agent/internal/environment/lab/virtual"
+	"github.com/aau-network-security/haaukins-agent/internal/logging"
+	"github.com/aau-network-security/haaukins-agent/internal/tracing"
 	"github.com/aau-network-security/haaukins-agent/pkg/proto"
+	"github.com/google/uuid"
 	"github.com/hashicorp/go-multierror"
-	"github.com/rs/zerolog/log"
+	"go.opentelemetry.io/otel/attribute"
 	"golang.org/x/exp/slices"
 )
 
+const defaultExposePort = 80
+
 // AddExercises uses exercise configs from the exercise service to configure containers and flags to be started at a later time
-func (l *Lab) AddExercises(ctx context.Context, confs ...exercise.ExerciseConfig) error {
+func (l *Lab) AddExercises(ctx context.Context, confs ...exercise.ExerciseConfig) (err error) {
+	ctx, span := tracing.Start(ctx, "AddExercises", tracing.LabTag(l.Tag), attribute.Int("haaukins.exercises", len(confs)))
+	defer func() { tracing.End(span, err) }()
+
 	var e *exercise.Exercise
 	var aRecord string
 
 	for _, conf := range confs {
 		if conf.Tag == "" {
-			return errors.New("No tags, need atleast one tag")
+			return exercise.MissingTagsErr
 		}
 
 		if _, ok := l.Exercises[conf.Tag]; ok {
-			return errors.New("Tag already exists")
+			return fmt.Errorf("%w: %s", exercise.DuplicateTagErr, conf.Tag)
 		}
 
 		if conf.Static {
@@ -33,7 +43,7 @@ func (l *Lab) AddExercises(ctx context.Context, confs ...exercise.ExerciseConfig
 			e = exercise.NewExercise(conf, nil, nil, "")
 		} else {
 			e = exercise.NewExercise(conf, l.Vlib, l.Network, l.DnsAddress)
-			if err := e.Create(ctx); err != nil {
+			if err := e.Create(logging.With(ctx, logging.ExerciseTag, conf.Tag)); err != nil {
 				return err
 			}
 			ip := strings.Split(e.DnsAddr, ".")
@@ -45,9 +55,13 @@ func (l *Lab) AddExercises(ctx context.Context, confs ...exercise.ExerciseConfig
 					}
 					if r.Type == "A" {
 						aRecord = r.Name
+						ipAddr := fmt.Sprintf("%s.%s.%s.%d", ip[0], ip[1], ip[2], e.Ips[i])
 						l.DnsRecords = append(l.DnsRecords, &DNSRecord{Record: map[string]string{
-							fmt.Sprintf("%s.%s.%s.%d", ip[0], ip[1], ip[2], e.Ips[i]): aRecord,
+							ipAddr: aRecord,
 						}})
+						if c.Expose {
+							l.exposeService(conf.Tag, aRecord, ipAddr, c.ExposePort)
+						}
 					}
 				}
 			}
@@ -66,13 +80,13 @@ func (l *Lab) AddAndStartExercises(ctx context.Context, exerConfs ...exercise.Ex
 	defer l.M.Unlock()
 
 	if err := l.AddExercises(ctx, exerConfs...); err != nil {
-		log.Error().Err(err).Msg("error adding exercise to lab")
+		logging.Ctx(ctx).Error().Err(err).Msg("error adding exercise to lab")
 		return err
 	}
 
 	// Refresh the DNS
 	if err := l.RefreshDNS(ctx); err != nil {
-		log.Error().Err(err).Msg("error refreshing DNS")
+		logging.Ctx(ctx).Error().Err(err).Msg("error refreshing DNS")
 		return err
 	}
 
@@ -107,7 +121,7 @@ func (l *Lab) AddAndStartExercises(ctx context.Context, exerConfs ...exercise.Ex
 func (l *Lab) StartExercise(ctx context.Context, exTag string) error {
 	e, ok := l.Exercises[exTag]
 	if !ok {
-		return fmt.Errorf("could not find exercise with tag: %s", exTag)
+		return fmt.Errorf("%w: %s", exercise.UnknownTagErr, exTag)
 	}
 
 	if err := e.Start(ctx); err != nil {
@@ -119,7 +133,7 @@ func (l *Lab) StartExercise(ctx context.Context, exTag string) error {
 func (l *Lab) StopExercise(ctx context.Context, exTag string) error {
 	e, ok := l.Exercises[exTag]
 	if !ok {
-		return fmt.Errorf("could not find exercise with tag: %s", exTag)
+		return fmt.Errorf("%w: %s", exercise.UnknownTagErr, exTag)
 	}
 
 	if err := e.Stop(ctx); err != nil {
@@ -131,7 +145,7 @@ func (l *Lab) StopExercise(ctx context.Context, exTag string) error {
 func (l *Lab) ResetExercise(ctx context.Context, exTag string) error {
 	e, ok := l.Exercises[exTag]
 	if !ok {
-		return fmt.Errorf("could not find exercise with tag: %s", exTag)
+		return fmt.Errorf("%w: %s", exercise.UnknownTagErr, exTag)
 	}
 
 	if err := e.Reset(ctx); err != nil {
@@ -174,3 +188,43 @@ func (l *Lab) GetExercisesInfo() []*proto.Exercise {
 	}
 	return exercises
 }
+
+// Makes a web service on the lab network reachable through the proxy using a random token
+func (l *Lab) exposeService(exTag, name, ipAddr string, port uint) {
+	if port == 0 {
+		port = defaultExposePort
+	}
+	l.ExposedServices = append(l.ExposedServices, ExposedService{
+		Token:       strings.ReplaceAll(uuid.New().String(), "-", ""),
+		Name:        name,
+		ExerciseTag: exTag,
+		Address:     net.JoinHostPort(ipAddr, strconv.Itoa(int(port))),
+	})
+}
+
+// Returns the exposed web service with the given token
+func (l *Lab) ExposedService(token string) (ExposedService, bool) {
+	for _, s := range l.ExposedServices {
+		if s.Token == token {
+			return s, true
+		}
+	}
+	return ExposedService{}, false
+}
+
+// Returns the first running container of an instance configured with a terminal,
+// which participants can get a shell in without a frontend
+func (l *Lab) TerminalContainer() (*virtual.Container, error) {
+	for _, e := range l.Exercises {
+		// Machines are created in the order of the container options
+		for i, opt := range e.ContainerOpts {
+			if !opt.Terminal || i >= len(e.Machines) {
+				continue
+			}
+			if c, ok := e.Machines[i].(*virtual.Container); ok && c.Id != "" {
+				return c, nil
+			}
+		}
+	}
+	return nil, NoTerminalContainerErr
+}
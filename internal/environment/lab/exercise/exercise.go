@@ -8,8 +8,9 @@ import (
 	"sync"
 
 	"github.com/aau-network-security/haaukins-agent/internal/environment/lab/virtual"
+	"github.com/aau-network-security/haaukins-agent/internal/logging"
+	"github.com/aau-network-security/haaukins-agent/internal/tracing"
 	"github.com/hashicorp/go-multierror"
-	"github.com/rs/zerolog/log"
 )
 
 var (
@@ -54,9 +55,18 @@ func NewExercise(conf ExerciseConfig, vlib *virtual.VboxLibrary, net *virtual.Ne
 	return ex
 }
 
-func (e *Exercise) Create(ctx context.Context) error {
+func (e *Exercise) Create(ctx context.Context) (err error) {
+	ctx, span := tracing.Start(ctx, "Exercise.Create", tracing.ExerciseTag(e.Tag))
+	defer func() { tracing.End(span, err) }()
+
 	var machines []virtual.Instance
 	var newIps []int
+	// Machines created before an error are removed, since the exercise does not keep them
+	defer func() {
+		if err != nil {
+			closeMachines(ctx, machines)
+		}
+	}()
 	for i, opt := range e.ContainerOpts {
 		opt.DockerConf.DNS = []string{e.DnsAddr}
 		opt.DockerConf.Labels = map[string]string{
@@ -67,6 +77,7 @@ func (e *Exercise) Create(ctx context.Context) error {
 		if err != nil {
 			return err
 		}
+		machines = append(machines, c)
 
 		var lastDigit int
 		// Example: 216
@@ -96,8 +107,6 @@ func (e *Exercise) Create(ctx context.Context) error {
 			}
 			e.DnsRecords = append(e.DnsRecords, record)
 		}
-
-		machines = append(machines, c)
 	}
 
 	for _, vboxConf := range e.VboxOpts {
@@ -158,7 +167,7 @@ func (e *Exercise) Stop(ctx context.Context) error {
 }
 
 func (e *Exercise) Reset(ctx context.Context) error {
-	if err := e.Close(); err != nil {
+	if err := e.Close(ctx); err != nil {
 		return err
 	}
 
@@ -172,23 +181,26 @@ func (e *Exercise) Reset(ctx context.Context) error {
 	return nil
 }
 
-func (e *Exercise) Close() error {
+func (e *Exercise) Close(ctx context.Context) error {
+	closeMachines(ctx, e.Machines)
+	e.Machines = nil
+	return nil
+}
+
+func closeMachines(ctx context.Context, machines []virtual.Instance) {
 	var wg sync.WaitGroup
 
-	for _, m := range e.Machines {
+	for _, m := range machines {
 		wg.Add(1)
 		go func(i virtual.Instance) {
 			if err := i.Close(); err != nil {
-				log.Warn().Msgf("error while closing exercise: %s", err)
+				logging.Ctx(ctx).Warn().Err(err).Msg("error while closing exercise")
 			}
 			wg.Done()
 		}(m)
 
 	}
 	wg.Wait()
-
-	e.Machines = nil
-	return nil
 }
 
 func CreateContainer(ctx context.Context, conf virtual.ContainerConfig) (*virtual.Container, error) {
@@ -249,6 +261,9 @@ func (e ExerciseConfig) CreateContainerOpts() []ContainerOptions {
 			DockerConf:     spec,
 			Records:        conf.Records,
 			ChildExercises: childExercises,
+			Expose:         conf.Expose,
+			ExposePort:     conf.ExposePort,
+			Terminal:       conf.Terminal,
 		})
 	}
 
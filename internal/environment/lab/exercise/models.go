@@ -35,12 +35,20 @@ type ExerciseInstanceConfig struct {
 	Envs     []EnvVarConfig       `json:"envs,omitempty"`
 	Flags    []ChildrenChalConfig `json:"children,omitempty"`
 	Records  []RecordConfig       `json:"records,omitempty"`
+	// Exposes the A records of the instance as web services through the proxy
+	Expose     bool `json:"expose,omitempty"`
+	ExposePort uint `json:"exposePort,omitempty"`
+	// Teams can open a browser terminal in the instance, see CreateTerminalAccess
+	Terminal bool `json:"terminal,omitempty"`
 }
 
 type ContainerOptions struct {
 	DockerConf     virtual.ContainerConfig
 	Records        []RecordConfig
 	ChildExercises []ChildExercise
+	Expose         bool
+	ExposePort     uint
+	Terminal       bool
 }
 
 type ChildrenChalConfig struct {
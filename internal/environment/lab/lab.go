@@ -2,23 +2,28 @@ package lab
 
 import (
 	"context"
-	"errors"
 	"fmt"
 	"io"
 	"io/ioutil"
 	"net/http"
+	"sort"
 	"strconv"
 	"strings"
 	"sync"
+	"time"
 
 	wgproto "github.com/aau-network-security/gwireguard/proto" //v1.0.3
 	"github.com/aau-network-security/haaukins-agent/internal/environment/lab/exercise"
 	"github.com/aau-network-security/haaukins-agent/internal/environment/lab/network/dhcp"
 	"github.com/aau-network-security/haaukins-agent/internal/environment/lab/network/dns"
 	"github.com/aau-network-security/haaukins-agent/internal/environment/lab/virtual"
+	"github.com/aau-network-security/haaukins-agent/internal/logging"
+	"github.com/aau-network-security/haaukins-agent/internal/metrics"
+	"github.com/aau-network-security/haaukins-agent/internal/tracing"
 	"github.com/google/uuid"
 	"github.com/hashicorp/go-multierror"
 	"github.com/rs/zerolog/log"
+	"go.opentelemetry.io/otel/attribute"
 )
 
 const defaultImageMEMMB = 4096
@@ -52,7 +57,10 @@ func (lType LabType) String() string {
 // TODO Add comments to remaining functions
 
 // Creates and starts a new virtual lab
-func (lc *LabConf) NewLab(ctx context.Context, isVPN bool, labType LabType, eventTag string) (Lab, error) {
+func (lc *LabConf) NewLab(ctx context.Context, isVPN bool, labType LabType, eventTag string) (_ Lab, err error) {
+	ctx, span := tracing.Start(ctx, "NewLab", tracing.EventTag(eventTag), attribute.Bool("haaukins.vpn", isVPN))
+	defer func() { tracing.End(span, err) }()
+
 	lab := Lab{
 		M:               &sync.RWMutex{},
 		Exercises:       make(map[string]*exercise.Exercise),
@@ -61,19 +69,32 @@ func (lc *LabConf) NewLab(ctx context.Context, isVPN bool, labType LabType, even
 		GuacUsername:    uuid.New().String()[0:8],
 		GuacPassword:    uuid.New().String()[0:8],
 		IsVPN:           isVPN,
+		DesktopPolicy:   lc.DesktopPolicy,
 	}
+	// Remove what was created before an error, so a retried task does not leave a partial lab behind
+	defer func() {
+		if err != nil {
+			if cerr := lab.Close(ctx); cerr != nil {
+				logging.Ctx(ctx).Error().Err(cerr).Msg("error closing partially created lab")
+			}
+		}
+	}()
 
 	// Create lab network
+	start := time.Now()
 	if err := lab.CreateNetwork(ctx, isVPN); err != nil {
-		return Lab{}, fmt.Errorf("error creating network for lab: %v", err)
+		return Lab{}, fmt.Errorf("error creating network for lab: %w", err)
 	}
+	metrics.ObserveLabStage(eventTag, metrics.StageNetwork, start)
 
 	// If labtype is beginner lab, ready all exercises from the start
 	if labType == TypeBeginner {
 		// Add exercises to new lab
+		start = time.Now()
 		if err := lab.AddExercises(ctx, lc.ExerciseConfs...); err != nil {
-			return Lab{}, fmt.Errorf("error adding exercises to lab: %v", err)
+			return Lab{}, fmt.Errorf("error adding exercises to lab: %w", err)
 		}
+		metrics.ObserveLabStage(eventTag, metrics.StageExercises, start)
 	}
 
 	lab.DockerHost = virtual.NewHost()
@@ -81,37 +102,63 @@ func (lc *LabConf) NewLab(ctx context.Context, isVPN bool, labType LabType, even
 	// Generate unique tag for lab
 	lab.Tag = generateTag(eventTag)
 	lab.Type = labType
+	span.SetAttributes(tracing.LabTag(lab.Tag))
+	ctx = logging.With(ctx, logging.LabTag, lab.Tag)
 
 	// If not a VPN lab
 	if !isVPN {
 		// Configure and add frontends to lab
 		lab.Frontends = map[uint]FrontendConf{}
+		start = time.Now()
 		for _, f := range lc.Frontends {
 			port := virtual.GetAvailablePort()
 			if _, err := lab.addFrontend(ctx, f, port); err != nil {
 				return Lab{}, err
 			}
 		}
+		metrics.ObserveLabStage(eventTag, metrics.StageFrontends, start)
 	}
 
 	return lab, nil
 }
 
+// Returns the amount of containers and VMs in the lab, including the DNS and DHCP servers and the frontends
+func (l *Lab) InstanceCounts() (containers, vms int) {
+	for _, e := range l.Exercises {
+		for _, m := range e.Machines {
+			switch m.(type) {
+			case *virtual.Container:
+				containers++
+			case *virtual.Vm:
+				vms++
+			}
+		}
+	}
+	if l.DnsServer != nil {
+		containers++
+	}
+	if l.DhcpServer != nil {
+		containers++
+	}
+	vms += len(l.Frontends)
+	return containers, vms
+}
+
 func (l *Lab) Start(ctx context.Context) error {
 	if err := l.RefreshDNS(ctx); err != nil {
-		log.Error().Err(err).Msg("error refreshing dns")
+		logging.Ctx(ctx).Error().Err(err).Msg("error refreshing dns")
 		return err
 	}
 
 	var err error
 	l.DhcpServer, err = dhcp.New(l.Network.FormatIP)
 	if err != nil {
-		log.Error().Err(err).Msg("error creating dhcpserver")
+		logging.Ctx(ctx).Error().Err(err).Msg("error creating dhcpserver")
 		return err
 	}
 
 	if err := l.DhcpServer.Run(ctx); err != nil {
-		log.Error().Err(err).Msg("error running dhcpserver")
+		logging.Ctx(ctx).Error().Err(err).Msg("error running dhcpserver")
 		return err
 	}
 
@@ -142,7 +189,7 @@ func (l *Lab) Start(ctx context.Context) error {
 	return nil
 }
 
-func (l *Lab) Close() error {
+func (l *Lab) Close(ctx context.Context) error {
 	var wg sync.WaitGroup
 	for _, lab := range l.Frontends {
 		wg.Add(1)
@@ -150,7 +197,7 @@ func (l *Lab) Close() error {
 			// closing VMs....
 			defer wg.Done()
 			if err := vm.Close(); err != nil {
-				log.Error().Msgf("Error on Close function in lab.go %s", err)
+				logging.Ctx(ctx).Error().Msgf("Error on Close function in lab.go %s", err)
 			}
 		}(lab.Vm)
 	}
@@ -171,30 +218,42 @@ func (l *Lab) Close() error {
 			closers = append(closers, l.DnsServer)
 		}
 
-		for _, e := range l.Exercises {
-			closers = append(closers, e)
-		}
-
 		for _, closer := range closers {
 			wg.Add(1)
 			go func(c io.Closer) {
 				if err := c.Close(); err != nil {
-					log.Error().Err(err).Msg("error while closing lab")
+					logging.Ctx(ctx).Error().Err(err).Msg("error while closing lab")
 				}
 				wg.Done()
 			}(closer)
 		}
 
+		for _, e := range l.Exercises {
+			wg.Add(1)
+			go func(e *exercise.Exercise) {
+				if err := e.Close(ctx); err != nil {
+					logging.Ctx(ctx).Error().Err(err).Msg("error while closing lab")
+				}
+				wg.Done()
+			}(e)
+		}
+
 	}()
 	wg.Wait()
 
+	if l.Network == nil {
+		return nil
+	}
 	if err := l.Network.Close(); err != nil {
-		log.Error().Err(err).Msg("error while closing network for lab")
+		logging.Ctx(ctx).Error().Err(err).Msg("error while closing network for lab")
 	}
 	return nil
 }
 
-func (l *Lab) RefreshDNS(ctx context.Context) error {
+func (l *Lab) RefreshDNS(ctx context.Context) (err error) {
+	ctx, span := tracing.Start(ctx, "RefreshDNS", tracing.LabTag(l.Tag))
+	defer func() { tracing.End(span, err) }()
+
 	if l.DnsServer != nil {
 		if err := l.DnsServer.Close(); err != nil {
 			return err
@@ -253,7 +312,7 @@ func (l *Lab) RefreshDHCP(ctx context.Context) error {
 func (l *Lab) CreateNetwork(ctx context.Context, isVPN bool) error {
 	network, err := virtual.NewNetwork(isVPN)
 	if err != nil {
-		return fmt.Errorf("docker new network err %v", err)
+		return fmt.Errorf("docker new network err %w", err)
 	}
 	l.Network = network
 	l.Network.SetIsVPN(isVPN)
@@ -268,20 +327,22 @@ func (l *Lab) addFrontend(ctx context.Context, conf virtual.InstanceConfig, rdpP
 	}
 	var mem uint
 	if conf.MemoryMB <= 0 || conf.MemoryMB < defaultImageMEMMB/2 {
-		log.Debug().Msgf("Memory cannot be smaller or equal to zero or less than [ %d ], setting it to default value [ %d ] ", defaultImageMEMMB/2, defaultImageMEMMB)
+		logging.Ctx(ctx).Debug().Msgf("Memory cannot be smaller or equal to zero or less than [ %d ], setting it to default value [ %d ] ", defaultImageMEMMB/2, defaultImageMEMMB)
 		mem = defaultImageMEMMB
-		log.Warn().
+		logging.Ctx(ctx).Warn().
 			Uint("memory", conf.MemoryMB).
 			Str("image", conf.Image).
 			Msgf(" Image does not have proper memory value setting it to %d  ", defaultImageMEMMB)
 	} else {
 		mem = conf.MemoryMB
 	}
+	width, height, depth := l.DesktopPolicy.VideoMode()
 	vm, err := l.Vlib.GetCopy(
 		ctx,
 		conf,
 		virtual.SetBridge(l.Network.Interface()),
-		virtual.SetLocalRDP(hostIp, rdpPort),
+		virtual.SetLocalRDP(hostIp, rdpPort, l.DesktopPolicy.ClipboardMode()),
+		virtual.SetVideoMode(width, height, depth),
 		virtual.SetRAM(mem),
 	)
 	if err != nil {
@@ -293,7 +354,7 @@ func (l *Lab) addFrontend(ctx context.Context, conf virtual.InstanceConfig, rdpP
 		Conf: conf,
 	}
 
-	log.Debug().Msgf("Created lab frontend on port %d", rdpPort)
+	logging.Ctx(ctx).Debug().Msgf("Created lab frontend on port %d", rdpPort)
 
 	return vm, nil
 }
@@ -301,7 +362,7 @@ func (l *Lab) addFrontend(ctx context.Context, conf virtual.InstanceConfig, rdpP
 func (l *Lab) ResetVm(ctx context.Context, port uint, envTag string) error {
 	frontendConf, ok := l.Frontends[port]
 	if !ok {
-		return errors.New("no vm running in lab on that port")
+		return VmNotFoundErr
 	}
 	if err := frontendConf.Vm.Close(); err != nil {
 		return err
@@ -316,7 +377,7 @@ func (l *Lab) ResetVm(ctx context.Context, port uint, envTag string) error {
 		return err
 	}
 
-	err = virtual.CreateFolderLink(vm.Info().Id, envTag, l.GuacUsername)
+	err = virtual.CreateFolderLink(vm.Info().Id, envTag, l.GuacUsernameForPort(port))
 	if err != nil {
 		log.Logger.Debug().Msgf("Error creating shared folder link after vm reset: %s", err)
 	}
@@ -334,6 +395,47 @@ func (l *Lab) RdpConnPorts() []uint {
 	return ports
 }
 
+// Assigns a team member with their own guacamole credentials to each frontend in the lab.
+// The first member reuses the credentials of the lab, so the lab credentials still log in to a frontend.
+func (l *Lab) AssignMembers() {
+	ports := l.RdpConnPorts()
+	sort.Slice(ports, func(i, j int) bool { return ports[i] < ports[j] })
+
+	l.Members = nil
+	for i, port := range ports {
+		member := Member{
+			GuacUsername: uuid.New().String()[0:8],
+			GuacPassword: uuid.New().String()[0:8],
+			RdpPort:      port,
+		}
+		if i == 0 {
+			member.GuacUsername = l.GuacUsername
+			member.GuacPassword = l.GuacPassword
+		}
+		l.Members = append(l.Members, member)
+	}
+}
+
+// Returns the team member with the given guacamole username
+func (l *Lab) MemberByUsername(username string) (Member, bool) {
+	for _, m := range l.Members {
+		if m.GuacUsername == username {
+			return m, true
+		}
+	}
+	return Member{}, false
+}
+
+// Returns the guacamole user which has access to the frontend on a port
+func (l *Lab) GuacUsernameForPort(port uint) string {
+	for _, m := range l.Members {
+		if m.RdpPort == port {
+			return m.GuacUsername
+		}
+	}
+	return l.GuacUsername
+}
+
 // Get a list of instance information for the VMs and exercises running in the lab
 func (l *Lab) InstanceInfo() []virtual.InstanceInfo {
 	var instances []virtual.InstanceInfo
@@ -371,10 +473,13 @@ type VpnConfig struct {
 	TeamSize        int
 }
 
-func (lab *Lab) CreateVPNConfigs(wgClient wgproto.WireguardClient, envTag string, vpnConfig VpnConfig) ([]string, []string, error) {
-	var labConfigFiles []string
+func (lab *Lab) CreateVPNConfigs(ctx context.Context, wgClient wgproto.WireguardClient, envTag string, vpnConfig VpnConfig) (_ []string, _ []string, err error) {
+	ctx, span := tracing.Start(ctx, "CreateVPNConfigs", tracing.EventTag(envTag), tracing.LabTag(lab.Tag))
+	defer func() { tracing.End(span, err) }()
+	// The wireguard service continues the trace
+	ctx = tracing.Inject(ctx)
 
-	ctx := context.Background()
+	var labConfigFiles []string
 	var vpnIPs []string
 	vpnInstructions := getContent(vpnInfo)
 	// var hosts string
@@ -392,10 +497,10 @@ func (lab *Lab) CreateVPNConfigs(wgClient wgproto.WireguardClient, envTag string
 	endpoint := fmt.Sprintf("%s.%s:%d", envTag, vpnConfig.Host, vpnConfig.VPNEndpointPort)
 
 	// get public key of server
-	log.Info().Msg("Getting server public key...")
+	logging.Ctx(ctx).Info().Msg("Getting server public key...")
 	serverPubKey, err := wgClient.GetPublicKey(ctx, &wgproto.PubKeyReq{PubKeyName: envTag, PrivKeyName: envTag})
 	if err != nil {
-		log.Error().Err(err).Msg("error getting server public key")
+		logging.Ctx(ctx).Error().Err(err).Msg("error getting server public key")
 		return []string{}, []string{}, err
 	}
 
@@ -404,39 +509,39 @@ func (lab *Lab) CreateVPNConfigs(wgClient wgproto.WireguardClient, envTag string
 	i := 0
 	for {
 		if len(labConfigFiles) >= vpnConfig.TeamSize {
-			log.Debug().Msg("all configs created")
+			logging.Ctx(ctx).Debug().Msg("all configs created")
 			break
 		}
 		if len(vpnConfig.IpAddresses[i]) > 0 {
 			ipAddr := pop(&vpnConfig.IpAddresses[i])
-			log.Info().Msg("creating VPN config")
+			logging.Ctx(ctx).Info().Msg("creating VPN config")
 			// generate client privatekey
-			log.Info().Msgf("Generating privatekey for lab %s", envTag+"_"+lab.Tag+"_"+strconv.Itoa(ipAddr))
+			logging.Ctx(ctx).Info().Msgf("Generating privatekey for lab %s", envTag+"_"+lab.Tag+"_"+strconv.Itoa(ipAddr))
 			_, err = wgClient.GenPrivateKey(ctx, &wgproto.PrivKeyReq{PrivateKeyName: envTag + "_" + lab.Tag + "_" + strconv.Itoa(ipAddr)})
 			if err != nil {
-				log.Error().Err(err).Msg("error generating private key")
+				logging.Ctx(ctx).Error().Err(err).Msg("error generating private key")
 				return []string{}, []string{}, err
 			}
 
 			// generate client public key
-			log.Info().Msgf("Generating public key for lab %s", envTag+"_"+lab.Tag+"_"+strconv.Itoa(ipAddr))
+			logging.Ctx(ctx).Info().Msgf("Generating public key for lab %s", envTag+"_"+lab.Tag+"_"+strconv.Itoa(ipAddr))
 			_, err = wgClient.GenPublicKey(ctx, &wgproto.PubKeyReq{PubKeyName: envTag + "_" + lab.Tag + "_" + strconv.Itoa(ipAddr), PrivKeyName: envTag + "_" + lab.Tag + "_" + strconv.Itoa(ipAddr)})
 			if err != nil {
-				log.Error().Err(err).Msg("error generating public key")
+				logging.Ctx(ctx).Error().Err(err).Msg("error generating public key")
 				return []string{}, []string{}, err
 			}
 
 			// get client public key
-			log.Info().Msgf("Retrieving public key for lab %s", envTag+"_"+lab.Tag+"_"+strconv.Itoa(ipAddr))
+			logging.Ctx(ctx).Info().Msgf("Retrieving public key for lab %s", envTag+"_"+lab.Tag+"_"+strconv.Itoa(ipAddr))
 			resp, err := wgClient.GetPublicKey(ctx, &wgproto.PubKeyReq{PubKeyName: envTag + "_" + lab.Tag + "_" + strconv.Itoa(ipAddr)})
 			if err != nil {
-				log.Error().Msgf("Error on GetPublicKey %v", err)
+				logging.Ctx(ctx).Error().Msgf("Error on GetPublicKey %v", err)
 				return []string{}, []string{}, err
 			}
 			// Adding 240 as this is the lower bound for the vpn subnet
 			peerIP := strings.Replace(vpnSubnet, "240.1/22", fmt.Sprintf("%d.%d/32", 240+i, ipAddr), 2)
 			gwIP := strings.Replace(vpnSubnet, "1/22", fmt.Sprintf("1/32"), 1)
-			log.Info().Str("NIC", envTag).
+			logging.Ctx(ctx).Info().Str("NIC", envTag).
 				Str("AllowedIPs", peerIP).
 				Str("PublicKey ", resp.Message).Msgf("Generating ip address for lab %s, ip address of peer is %s ", lab.Tag, peerIP)
 			addPeerResp, err := wgClient.AddPeer(ctx, &wgproto.AddPReq{
@@ -445,19 +550,19 @@ func (lab *Lab) CreateVPNConfigs(wgClient wgproto.WireguardClient, envTag string
 				PublicKey:  resp.Message,
 			})
 			if err != nil {
-				log.Error().Msgf("Error on adding peer to interface %v", err)
+				logging.Ctx(ctx).Error().Msgf("Error on adding peer to interface %v", err)
 				return []string{}, []string{}, err
 			}
-			log.Info().Str("Event: ", envTag).
+			logging.Ctx(ctx).Info().Str("Event: ", envTag).
 				Str("Lab: ", lab.Tag).Msgf("Message : %s", addPeerResp.Message)
 
 			labPrivKey, err := wgClient.GetPrivateKey(ctx, &wgproto.PrivKeyReq{PrivateKeyName: envTag + "_" + lab.Tag + "_" + strconv.Itoa(ipAddr)})
 			if err != nil {
-				log.Error().Err(err).Msg("error getting private key")
+				logging.Ctx(ctx).Error().Err(err).Msg("error getting private key")
 				return []string{}, []string{}, err
 			}
-			log.Info().Msgf("Private key for lab %s is %s ", lab.Tag, labPrivKey.Message)
-			log.Info().Msgf("Client configuration is created for server %s", endpoint)
+			logging.Ctx(ctx).Info().Str("labTag", lab.Tag).Msg("got private key for lab")
+			logging.Ctx(ctx).Info().Msgf("Client configuration is created for server %s", endpoint)
 
 			// creating client configuration file
 			clientConfig := fmt.Sprintf(
@@ -496,7 +601,7 @@ PersistentKeepalive = 25
 			labConfigFiles = append(labConfigFiles, clientConfig)
 			vpnIPs = append(vpnIPs, peerIP)
 		} else if i == len(vpnConfig.IpAddresses)-1 && len(vpnConfig.IpAddresses[i]) == 0 { // last set of ips is empty therefore no more ips left
-			log.Error().Msg("no ip addresses left")
+			logging.Ctx(ctx).Error().Msg("no ip addresses left")
 			break // Return the vpnConfigs created if any at all
 		} else { // Whenever an array of ints have been exhausted we go for the next set
 			i += 1
@@ -525,6 +630,6 @@ func getContent(link string) string {
 func pop(alist *[]int) int {
 	f := len(*alist)
 	rv := (*alist)[f-1]
-	*alist = append((*alist)[:f-1])
+	*alist = (*alist)[:f-1]
 	return rv
 }
@@ -27,7 +27,10 @@ type Lab struct {
 	IsVPN             bool
 	GuacUsername      string
 	GuacPassword      string
+	Members           []Member
+	ExposedServices   []ExposedService
 	VpnConfs          []string
+	DesktopPolicy     virtual.DesktopPolicy
 }
 
 type LabConf struct {
@@ -35,12 +38,30 @@ type LabConf struct {
 	Frontends         []virtual.InstanceConfig
 	ExerciseConfs     []exercise.ExerciseConfig
 	DisabledExercises []string
+	DesktopPolicy     virtual.DesktopPolicy
 }
 
 type DNSRecord struct {
 	Record map[string]string
 }
 
+// A team member in a lab with more than one member.
+// Each member has their own guacamole user which can only access the frontend on RdpPort.
+type Member struct {
+	GuacUsername string
+	GuacPassword string
+	RdpPort      uint
+}
+
+// A web service in an exercise which is reachable through the proxy without VPN.
+// The random token is used as subdomain, so only teams who got the URL of their lab can reach the service.
+type ExposedService struct {
+	Token       string
+	Name        string
+	ExerciseTag string
+	Address     string
+}
+
 type FrontendConf struct {
 	Vm   *virtual.Vm
 	Conf virtual.InstanceConfig
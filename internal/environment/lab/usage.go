@@ -0,0 +1,90 @@
+package lab
+
+import (
+	"context"
+	"sync"
+
+	"github.com/aau-network-security/haaukins-agent/internal/environment/lab/virtual"
+	"github.com/hashicorp/go-multierror"
+)
+
+// Resource usage of a lab, summed over the containers and VMs which could be sampled.
+// The usage of each machine is kept by its id, so counters can be followed per machine.
+type Usage struct {
+	virtual.Usage
+	Containers int
+	Vms        int
+	Machines   map[string]virtual.Usage
+}
+
+type usageSampler interface {
+	Usage(ctx context.Context) (virtual.Usage, error)
+}
+
+// Samples the resource usage of every container and VM in the lab, including the DNS and DHCP servers and the frontends.
+// Machines are sampled concurrently. If some machines could not be sampled, the usage of the rest is returned with the errors.
+func (l *Lab) Usage(ctx context.Context) (Usage, error) {
+	var containers []*virtual.Container
+	var vms []*virtual.Vm
+
+	l.M.RLock()
+	for _, e := range l.Exercises {
+		for _, m := range e.Machines {
+			switch m := m.(type) {
+			case *virtual.Container:
+				containers = append(containers, m)
+			case *virtual.Vm:
+				vms = append(vms, m)
+			}
+		}
+	}
+	if l.DnsServer != nil && l.DnsServer.Container() != nil {
+		containers = append(containers, l.DnsServer.Container())
+	}
+	if l.DhcpServer != nil && l.DhcpServer.Container() != nil {
+		containers = append(containers, l.DhcpServer.Container())
+	}
+	for _, f := range l.Frontends {
+		if f.Vm != nil {
+			vms = append(vms, f.Vm)
+		}
+	}
+	l.M.RUnlock()
+
+	var (
+		m     sync.Mutex
+		wg    sync.WaitGroup
+		usage = Usage{Machines: make(map[string]virtual.Usage)}
+		errs  error
+	)
+	sample := func(id string, s usageSampler, isVm bool) {
+		defer wg.Done()
+		u, err := s.Usage(ctx)
+
+		m.Lock()
+		defer m.Unlock()
+		if err != nil {
+			errs = multierror.Append(errs, err)
+			return
+		}
+		usage.Add(u)
+		usage.Machines[id] = u
+		if isVm {
+			usage.Vms++
+		} else {
+			usage.Containers++
+		}
+	}
+
+	for _, c := range containers {
+		wg.Add(1)
+		go sample(c.Id, c, false)
+	}
+	for _, vm := range vms {
+		wg.Add(1)
+		go sample(vm.Id, vm, true)
+	}
+	wg.Wait()
+
+	return usage, errs
+}
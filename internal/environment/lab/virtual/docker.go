@@ -10,6 +10,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
 	"math/rand"
 	"net/http"
 	"os"
@@ -22,6 +23,8 @@ import (
 
 	"net"
 
+	"github.com/aau-network-security/haaukins-agent/internal/logging"
+	"github.com/aau-network-security/haaukins-agent/internal/tracing"
 	docker "github.com/fsouza/go-dockerclient"
 	"github.com/google/uuid"
 	"github.com/rs/zerolog/log"
@@ -97,6 +100,10 @@ func (err NoLocalImageAvailableErr) Error() string {
 	return fmt.Sprintf("no local image available: %s", err.err)
 }
 
+func (err NoLocalImageAvailableErr) Unwrap() error {
+	return err.err
+}
+
 type NoRemoteImageAvailableErr struct {
 	err error
 }
@@ -105,6 +112,10 @@ func (err NoRemoteImageAvailableErr) Error() string {
 	return fmt.Sprintf("failed to update local image to newest version from repository: %s", err.err)
 }
 
+func (err NoRemoteImageAvailableErr) Unwrap() error {
+	return err.err
+}
+
 type Host interface {
 	GetDockerHostIP() (string, error)
 }
@@ -315,7 +326,10 @@ func (c *Container) getCreateConfig() (*docker.CreateContainerOptions, error) {
 	}, nil
 }
 
-func (c *Container) Create(ctx context.Context) error {
+func (c *Container) Create(ctx context.Context) (err error) {
+	ctx, span := tracing.Start(ctx, "Container.Create", tracing.Image(c.Conf.Image))
+	defer func() { tracing.End(span, err) }()
+
 	dconf, err := c.getCreateConfig()
 	if err != nil {
 		return err
@@ -335,7 +349,7 @@ func (c *Container) Create(ctx context.Context) error {
 		}
 	}
 
-	log.Info().
+	logging.Ctx(ctx).Info().
 		Str("ID", cont.ID[0:8]).
 		Str("Image", c.Conf.Image).
 		Msg("Created new container")
@@ -360,7 +374,7 @@ func (c *Container) Start(ctx context.Context) error {
 		}
 	}
 
-	log.Debug().
+	logging.Ctx(ctx).Debug().
 		Str("ID", c.Id[0:8]).
 		Str("Image", c.Conf.Image).
 		Msg("Started container")
@@ -370,11 +384,11 @@ func (c *Container) Start(ctx context.Context) error {
 
 func (c *Container) Suspend(ctx context.Context) error {
 	if err := DefaultClient.PauseContainer(c.Id); err != nil {
-		log.Error().Str("ID", c.Id[0:8]).Msgf("Failed to suspend container: %s", err)
+		logging.Ctx(ctx).Error().Str("ID", c.Id[0:8]).Msgf("Failed to suspend container: %s", err)
 		return err
 	}
 
-	log.Debug().
+	logging.Ctx(ctx).Debug().
 		Str("ID", c.Id[0:8]).
 		Msg("Suspended container")
 
@@ -468,6 +482,61 @@ func (c *Container) BridgeAlias(alias string) (string, error) {
 	return DefaultLinkBridge.connect(c.Id, alias)
 }
 
+// Size of a terminal in columns and rows
+type TTYSize struct {
+	Cols uint
+	Rows uint
+}
+
+// Runs a command inside the container with a TTY attached, like "docker exec -it".
+// Input is read from stdin and the output of the TTY is written to stdout until the command exits or ctx is cancelled.
+// The TTY is resized every time a new size is received on resize.
+func (c *Container) ExecTTY(ctx context.Context, cmd []string, stdin io.Reader, stdout io.Writer, resize <-chan TTYSize) error {
+	exec, err := DefaultClient.CreateExec(docker.CreateExecOptions{
+		Container:    c.Id,
+		Cmd:          cmd,
+		AttachStdin:  true,
+		AttachStdout: true,
+		AttachStderr: true,
+		Tty:          true,
+		Context:      ctx,
+	})
+	if err != nil {
+		return err
+	}
+
+	cw, err := DefaultClient.StartExecNonBlocking(exec.ID, docker.StartExecOptions{
+		InputStream:  stdin,
+		OutputStream: stdout,
+		ErrorStream:  stdout,
+		Tty:          true,
+		RawTerminal:  true,
+		Context:      ctx,
+	})
+	if err != nil {
+		return err
+	}
+	defer cw.Close()
+
+	done := make(chan error, 1)
+	go func() {
+		done <- cw.Wait()
+	}()
+
+	for {
+		select {
+		case size := <-resize:
+			if err := DefaultClient.ResizeExecTTY(exec.ID, int(size.Rows), int(size.Cols)); err != nil {
+				logging.Ctx(ctx).Debug().Err(err).Str("ID", c.Id[0:8]).Msg("error resizing exec tty")
+			}
+		case err := <-done:
+			return err
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
 type Network struct {
 	Net       *docker.Network
 	Subnet    string
@@ -506,7 +575,7 @@ func NewNetwork(isVPN bool) (*Network, error) {
 	netw, err := DefaultClient.CreateNetwork(conf)
 	if err != nil {
 		log.Debug().Msgf("Overlaps err: Some of the containers having same IP addresses...")
-		return nil, fmt.Errorf("docker CreateNetwork err %v", err)
+		return nil, fmt.Errorf("docker CreateNetwork err %w", err)
 	}
 
 	netInfo, _ := DefaultClient.NetworkInfo(netw.ID)
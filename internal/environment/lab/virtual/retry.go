@@ -0,0 +1,66 @@
+package virtual
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net"
+	"net/http"
+	"strings"
+
+	docker "github.com/fsouza/go-dockerclient"
+)
+
+// Parts of error messages from docker and VirtualBox which are known to be transient.
+// Errors are often formatted into new errors without wrapping, so the message is checked as well.
+var transientErrMessages = []string{
+	"i/o timeout",
+	"tls handshake timeout",
+	"connection reset by peer",
+	"connection refused",
+	"unexpected eof",
+	"deadline exceeded",
+	"timeout exceeded",
+	// Docker picked a subnet for the lab network which was taken in the meantime
+	"pool overlaps with other one",
+	// VirtualBox lock errors while another VBoxManage command holds the session
+	"is already locked",
+	"vbox_e_object_in_use",
+	"vbox_e_invalid_object_state",
+}
+
+// Returns true if the error from docker or VirtualBox, including the DHCP and DNS containers of a lab,
+// is likely to go away if the operation is tried again, like image pull timeouts, VirtualBox lock errors
+// and overlapping docker networks. Errors from invalid configuration or missing images are permanent.
+func IsTransientErr(err error) bool {
+	if err == nil {
+		return false
+	}
+	if errors.Is(err, context.Canceled) {
+		return false
+	}
+	if errors.Is(err, context.DeadlineExceeded) || errors.Is(err, io.ErrUnexpectedEOF) {
+		return true
+	}
+
+	var netErr net.Error
+	if errors.As(err, &netErr) && netErr.Timeout() {
+		return true
+	}
+
+	var dockerErr *docker.Error
+	if errors.As(err, &dockerErr) {
+		switch dockerErr.Status {
+		case http.StatusRequestTimeout, http.StatusTooManyRequests, http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+			return true
+		}
+	}
+
+	msg := strings.ToLower(err.Error())
+	for _, m := range transientErrMessages {
+		if strings.Contains(msg, m) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,214 @@
+package virtual
+
+import (
+	"context"
+	"encoding/xml"
+	"errors"
+	"strconv"
+	"strings"
+	"sync"
+
+	docker "github.com/fsouza/go-dockerclient"
+)
+
+var NoUsageErr = errors.New("no usage reported")
+
+// Resource usage of a container or VM when it was sampled.
+// CPU is in percent of a single core, so a machine using two cores fully uses 200 percent.
+// Network and block IO are counted from when the machine was started.
+type Usage struct {
+	CPUPercent      float64
+	MemoryBytes     uint64
+	NetRxBytes      uint64
+	NetTxBytes      uint64
+	BlockReadBytes  uint64
+	BlockWriteBytes uint64
+}
+
+func (u *Usage) Add(o Usage) {
+	u.CPUPercent += o.CPUPercent
+	u.MemoryBytes += o.MemoryBytes
+	u.NetRxBytes += o.NetRxBytes
+	u.NetTxBytes += o.NetTxBytes
+	u.BlockReadBytes += o.BlockReadBytes
+	u.BlockWriteBytes += o.BlockWriteBytes
+}
+
+// Samples the resource usage of the container from docker stats.
+// Docker waits for a second sample to calculate the CPU usage, so the call takes about a second.
+func (c *Container) Usage(ctx context.Context) (Usage, error) {
+	if c.Id == "" {
+		return Usage{}, ContNotCreatedErr
+	}
+
+	statsC := make(chan *docker.Stats, 1)
+	errC := make(chan error, 1)
+	go func() {
+		errC <- DefaultClient.Stats(docker.StatsOptions{
+			ID:      c.Id,
+			Stats:   statsC,
+			Stream:  false,
+			Context: ctx,
+		})
+	}()
+
+	stats, ok := <-statsC
+	if err := <-errC; err != nil {
+		return Usage{}, err
+	}
+	if !ok || stats == nil {
+		return Usage{}, NoUsageErr
+	}
+
+	var usage Usage
+	cpuDelta := float64(stats.CPUStats.CPUUsage.TotalUsage) - float64(stats.PreCPUStats.CPUUsage.TotalUsage)
+	systemDelta := float64(stats.CPUStats.SystemCPUUsage) - float64(stats.PreCPUStats.SystemCPUUsage)
+	cpus := float64(stats.CPUStats.OnlineCPUs)
+	if cpus == 0 {
+		cpus = float64(len(stats.CPUStats.CPUUsage.PercpuUsage))
+	}
+	if cpuDelta > 0 && systemDelta > 0 {
+		usage.CPUPercent = cpuDelta / systemDelta * cpus * 100
+	}
+
+	// Like docker stats, the page cache which can be reclaimed is not counted as used
+	cache := stats.MemoryStats.Stats.TotalInactiveFile
+	if cache == 0 {
+		cache = stats.MemoryStats.Stats.InactiveFile
+	}
+	if stats.MemoryStats.Usage > cache {
+		usage.MemoryBytes = stats.MemoryStats.Usage - cache
+	}
+
+	for _, n := range stats.Networks {
+		usage.NetRxBytes += n.RxBytes
+		usage.NetTxBytes += n.TxBytes
+	}
+	for _, e := range stats.BlkioStats.IOServiceBytesRecursive {
+		switch strings.ToLower(e.Op) {
+		case "read":
+			usage.BlockReadBytes += e.Value
+		case "write":
+			usage.BlockWriteBytes += e.Value
+		}
+	}
+	return usage, nil
+}
+
+// VMs which VirtualBox has been asked to collect metrics for
+var vmMetricsSetup sync.Map
+
+const (
+	vmMetricsPeriod = "10"
+	vmMetricCPUUser = "CPU/Load/User"
+	vmMetricCPUKern = "CPU/Load/Kernel"
+	vmMetricRAMUsed = "RAM/Usage/Used"
+)
+
+// Samples the resource usage of the VM. CPU and memory are from VBoxManage metrics, and network and block IO
+// from the counters of the network adapters and disks of the running VM. VirtualBox only collects metrics
+// after it has been set up for the VM, so NoUsageErr is returned until the first period has passed.
+func (vm *Vm) Usage(ctx context.Context) (Usage, error) {
+	if _, ok := vmMetricsSetup.Load(vm.Id); !ok {
+		if _, err := VBoxCmdContext(ctx, "metrics", "setup", "--period", vmMetricsPeriod, "--samples", "1", vm.Id); err != nil {
+			return Usage{}, err
+		}
+		vmMetricsSetup.Store(vm.Id, true)
+	}
+
+	out, err := VBoxCmdContext(ctx, "metrics", "query", vm.Id, strings.Join([]string{vmMetricCPUUser, vmMetricCPUKern, vmMetricRAMUsed}, ","))
+	if err != nil {
+		return Usage{}, err
+	}
+	usage, err := parseVmMetrics(out)
+	if err != nil {
+		return Usage{}, err
+	}
+
+	out, err = VBoxCmdContext(ctx, "debugvm", vm.Id, "statistics", "--pattern", vmIOCounters)
+	if err != nil {
+		return Usage{}, err
+	}
+	if err := parseVmIOCounters(out, &usage); err != nil {
+		return Usage{}, err
+	}
+	return usage, nil
+}
+
+// Counters of the bytes sent and received by the network adapters and read and written by the disks of a VM
+const vmIOCounters = "/Public/NetAdapter/*|*ReadBytes|*WrittenBytes"
+
+// Statistics of a VM from VBoxManage debugvm statistics, which are written as XML
+type vmStatistics struct {
+	Counters []struct {
+		Name  string `xml:"name,attr"`
+		Value uint64 `xml:"c,attr"`
+	} `xml:"Counter"`
+}
+
+func parseVmIOCounters(out []byte, usage *Usage) error {
+	var stats vmStatistics
+	if err := xml.Unmarshal(out, &stats); err != nil {
+		return err
+	}
+	for _, c := range stats.Counters {
+		switch {
+		case strings.HasPrefix(c.Name, "/Public/NetAdapter/") && strings.HasSuffix(c.Name, "/BytesReceived"):
+			usage.NetRxBytes += c.Value
+		case strings.HasPrefix(c.Name, "/Public/NetAdapter/") && strings.HasSuffix(c.Name, "/BytesTransmitted"):
+			usage.NetTxBytes += c.Value
+		case strings.HasPrefix(c.Name, "/Devices/") && strings.HasSuffix(c.Name, "/ReadBytes"):
+			usage.BlockReadBytes += c.Value
+		case strings.HasPrefix(c.Name, "/Devices/") && strings.HasSuffix(c.Name, "/WrittenBytes"):
+			usage.BlockWriteBytes += c.Value
+		}
+	}
+	return nil
+}
+
+// Parses the output of VBoxManage metrics query, which has a line for each metric like
+// "<vm name>   CPU/Load/User   12.00%" or "<vm name>   RAM/Usage/Used   1048576 kB"
+func parseVmMetrics(out []byte) (Usage, error) {
+	var usage Usage
+	found := false
+	for _, line := range strings.Split(string(out), "\n") {
+		fields := strings.Fields(line)
+		for i := 0; i < len(fields)-1; i++ {
+			value := fields[i+1]
+			switch fields[i] {
+			case vmMetricCPUUser, vmMetricCPUKern:
+				percent, err := strconv.ParseFloat(strings.TrimSuffix(value, "%"), 64)
+				if err != nil {
+					continue
+				}
+				usage.CPUPercent += percent
+				found = true
+			case vmMetricRAMUsed:
+				kb, err := strconv.ParseUint(value, 10, 64)
+				if err != nil {
+					continue
+				}
+				usage.MemoryBytes = kb * 1024
+				found = true
+			}
+		}
+	}
+	if !found {
+		return Usage{}, NoUsageErr
+	}
+	return usage, nil
+}
+
+// Forgets that metrics have been set up for the VM, used when the VM is removed
+func forgetVmMetrics(id string) {
+	vmMetricsSetup.Delete(id)
+}
+
+// Returns the directory docker stores images and containers in
+func DockerRootDir() (string, error) {
+	info, err := DefaultClient.Info()
+	if err != nil {
+		return "", err
+	}
+	return info.DockerRootDir, nil
+}
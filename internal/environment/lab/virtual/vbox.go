@@ -8,6 +8,7 @@ import (
 	"bytes"
 	"context"
 	"encoding/hex"
+	"errors"
 	"fmt"
 	"hash/crc32"
 	"io"
@@ -22,9 +23,12 @@ import (
 
 	"regexp"
 
+	"github.com/aau-network-security/haaukins-agent/internal/logging"
+	"github.com/aau-network-security/haaukins-agent/internal/tracing"
 	"github.com/google/uuid"
-	"github.com/rs/zerolog"
 	"github.com/rs/zerolog/log"
+	"go.opentelemetry.io/otel/attribute"
+	"golang.org/x/exp/slices"
 )
 
 // TODO comments and docs
@@ -34,6 +38,65 @@ type InstanceConfig struct {
 	CPU      float64 `yaml:"cpu"`
 }
 
+// Remote desktop policy for the frontends of an environment.
+// The zero value keeps the defaults with clipboard, drive mapping, wallpaper and audio enabled at 1920x1080 and 16 bit colours.
+type DesktopPolicy struct {
+	DisableCopy      bool `yaml:"disableCopy"`
+	DisablePaste     bool `yaml:"disablePaste"`
+	DisableDrive     bool `yaml:"disableDrive"`
+	DisableWallpaper bool `yaml:"disableWallpaper"`
+	DisableAudio     bool `yaml:"disableAudio"`
+	Width            uint `yaml:"width"`
+	Height           uint `yaml:"height"`
+	ColorDepth       uint `yaml:"colorDepth"`
+}
+
+const (
+	defaultVideoWidth  = 1920
+	defaultVideoHeight = 1080
+	defaultVideoDepth  = 16
+)
+
+func (p DesktopPolicy) Validate() error {
+	if (p.Width == 0) != (p.Height == 0) {
+		return errors.New("both width and height has to be set for the desktop resolution")
+	}
+
+	switch p.ColorDepth {
+	case 0, 8, 16, 24, 32:
+	default:
+		return errors.New("colorDepth can take the following values: 8, 16, 24, 32")
+	}
+
+	return nil
+}
+
+// Returns the VirtualBox clipboard mode matching the policy.
+// Copy is from the VM to the participant and paste is from the participant into the VM.
+func (p DesktopPolicy) ClipboardMode() string {
+	switch {
+	case p.DisableCopy && p.DisablePaste:
+		return "disabled"
+	case p.DisableCopy:
+		return "hosttoguest"
+	case p.DisablePaste:
+		return "guesttohost"
+	}
+	return "bidirectional"
+}
+
+// Returns the resolution and colour depth of the policy with defaults applied
+func (p DesktopPolicy) VideoMode() (uint, uint, uint) {
+	width, height, depth := p.Width, p.Height, p.ColorDepth
+	if width == 0 || height == 0 {
+		width, height = defaultVideoWidth, defaultVideoHeight
+	}
+	if depth == 0 {
+		depth = defaultVideoDepth
+	}
+	return width, height, depth
+}
+
 const (
 	stateRegex = `State:\s*(.*)`
 	nicRegex   = "\\bNIC\\b"
@@ -49,10 +112,6 @@ const (
 
 var FileTransferRoot string
 
-func init() {
-	zerolog.SetGlobalLevel(zerolog.Disabled)
-}
-
 type VBoxErr struct {
 	Action string
 	Output []byte
@@ -83,11 +142,14 @@ type VboxLibrary struct {
 
 // VM information is stored in a struct
 type Vm struct {
-	Id      string
-	Path    string
-	Image   string
-	opts    []VMOpt
-	Running bool
+	Id          string
+	Path        string
+	Image       string
+	opts        []VMOpt
+	Running     bool
+	VideoWidth  uint
+	VideoHeight uint
+	VideoDepth  uint
 }
 
 func NewVMWithSum(path, image string, checksum string, vmOpts ...VMOpt) *Vm {
@@ -132,16 +194,17 @@ func (vm *Vm) Start(ctx context.Context) error {
 
 	vm.Running = true
 
-	log.Debug().
+	logging.Ctx(ctx).Debug().
 		Str("ID", vm.Id).
 		Msg("Started VM")
 
-	log.Debug().
+	width, height, depth := DesktopPolicy{Width: vm.VideoWidth, Height: vm.VideoHeight, ColorDepth: vm.VideoDepth}.VideoMode()
+	logging.Ctx(ctx).Debug().
 		Str("ID", vm.Id).
 		Msg("Setting resolution for VM")
-	_, err = VBoxCmdContext(ctx, vboxCtrlVM, vm.Id, "setvideomodehint", "1920", "1080", "16")
+	_, err = VBoxCmdContext(ctx, vboxCtrlVM, vm.Id, "setvideomodehint", strconv.Itoa(int(width)), strconv.Itoa(int(height)), strconv.Itoa(int(depth)))
 	if err != nil {
-		log.Error().Str("ID", vm.Id).Msgf("Error setting resolution, VM may require reset on after connecting: %s", err.Error())
+		logging.Ctx(ctx).Error().Str("ID", vm.Id).Msgf("Error setting resolution, VM may require reset on after connecting: %s", err.Error())
 	}
 
 	return nil
@@ -167,13 +230,13 @@ func (vm *Vm) Stop() error {
 func (vm *Vm) Suspend(ctx context.Context) error {
 	_, err := VBoxCmdContext(ctx, vboxCtrlVM, vm.Id, "savestate")
 	if err != nil {
-		log.Error().
+		logging.Ctx(ctx).Error().
 			Str("ID", vm.Id).
 			Msgf("Failed to suspend VM: %v", err)
 		return err
 	}
 
-	log.Debug().
+	logging.Ctx(ctx).Debug().
 		Str("ID", vm.Id).
 		Msgf("Suspended vm")
 
@@ -195,6 +258,7 @@ func (vm *Vm) Close() error {
 	if err != nil {
 		return err
 	}
+	forgetVmMetrics(vm.Id)
 
 	log.Debug().
 		Str("ID", vm.Id).
@@ -242,7 +306,7 @@ func SetBridge(nic string) VMOpt {
 	}
 }
 
-func SetLocalRDP(ip string, port uint) VMOpt {
+func SetLocalRDP(ip string, port uint, clipboardMode string) VMOpt {
 	return func(ctx context.Context, vm *Vm) error {
 		_, err := VBoxCmdContext(ctx, vboxModVM, vm.Id, "--vrde", "on")
 		if err != nil {
@@ -269,7 +333,7 @@ func SetLocalRDP(ip string, port uint) VMOpt {
 			return err
 		}
 
-		_, err = VBoxCmdContext(ctx, vboxModVM, vm.Id, "--clipboard", "bidirectional")
+		_, err = VBoxCmdContext(ctx, vboxModVM, vm.Id, "--clipboard", clipboardMode)
 		if err != nil {
 			return err
 		}
@@ -283,6 +347,16 @@ func SetLocalRDP(ip string, port uint) VMOpt {
 	}
 }
 
+// Sets the resolution and colour depth the VM is set to when started
+func SetVideoMode(width, height, depth uint) VMOpt {
+	return func(ctx context.Context, vm *Vm) error {
+		vm.VideoWidth = width
+		vm.VideoHeight = height
+		vm.VideoDepth = depth
+		return nil
+	}
+}
+
 func SetCPU(cores uint) VMOpt {
 	return func(ctx context.Context, vm *Vm) error {
 		_, err := VBoxCmdContext(ctx, vboxModVM, vm.Id, "--cpus", fmt.Sprintf("%d", cores))
@@ -298,7 +372,7 @@ func SetRAM(mb uint) VMOpt {
 }
 
 func (vm *Vm) ensureStopped(ctx context.Context) (func(), error) {
-	log.Debug().Msgf("vm: %v", vm)
+	logging.Ctx(ctx).Debug().Msgf("vm: %v", vm)
 	wasRunning := vm.Running
 	if vm.Running {
 		if err := vm.Stop(); err != nil {
@@ -397,7 +471,10 @@ func (lib *VboxLibrary) GetImagePath(file string) string {
 	return file
 }
 
-func (lib *VboxLibrary) GetCopy(ctx context.Context, conf InstanceConfig, vmOpts ...VMOpt) (*Vm, error) {
+func (lib *VboxLibrary) GetCopy(ctx context.Context, conf InstanceConfig, vmOpts ...VMOpt) (_ *Vm, err error) {
+	ctx, span := tracing.Start(ctx, "VboxLibrary.GetCopy", tracing.Image(conf.Image))
+	defer func() { tracing.End(span, err) }()
+
 	path := lib.GetImagePath(conf.Image)
 
 	lib.M.Lock()
@@ -408,7 +485,7 @@ func (lib *VboxLibrary) GetCopy(ctx context.Context, conf InstanceConfig, vmOpts
 		lib.Locks[path] = pathLock
 	}
 
-	log.Debug().
+	logging.Ctx(ctx).Debug().
 		Str("path", path).
 		Bool("first_time", ok == false).
 		Msg("getting path lock")
@@ -432,6 +509,8 @@ func (lib *VboxLibrary) GetCopy(ctx context.Context, conf InstanceConfig, vmOpts
 	n := strings.TrimSuffix(filepath.Base(path), filepath.Ext(path))
 
 	vm, ok = VmExists(n, sum)
+	// Importing the OVA is what makes the first copy of an image slow
+	span.SetAttributes(attribute.Bool("haaukins.vm.imported", !ok))
 	if !ok {
 		vm = NewVMWithSum(path, n, sum)
 		if err := vm.Create(ctx); err != nil {
@@ -464,6 +543,23 @@ func (lib *VboxLibrary) GetCopy(ctx context.Context, conf InstanceConfig, vmOpts
 	return instance, nil
 }
 
+// Returns the names of the OVAs in the library without the .ova suffix
+func (lib *VboxLibrary) AvailableImages() ([]string, error) {
+	entries, err := os.ReadDir(lib.Pwd)
+	if err != nil {
+		return nil, err
+	}
+
+	var images []string
+	for _, e := range entries {
+		if e.IsDir() || !strings.HasSuffix(e.Name(), ".ova") {
+			continue
+		}
+		images = append(images, strings.TrimSuffix(e.Name(), ".ova"))
+	}
+	return images, nil
+}
+
 func (lib *VboxLibrary) IsAvailable(file string) bool {
 	path := lib.GetImagePath(file)
 	if _, err := os.Stat(path); err == nil {
@@ -587,6 +683,31 @@ func RemoveEventFolder(eventTag string) error {
 	}
 }
 
+// Removes everything in the event folder except for the entries given in keep.
+// Used to preserve ex. session recordings after an event has been closed.
+func RemoveEventFolderExcept(eventTag string, keep ...string) error {
+	path := FileTransferRoot + "/" + eventTag
+	entries, err := os.ReadDir(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			log.Info().Str("Event-folder", path).Msg("Event-folder does not exists... Continueing")
+			return nil
+		}
+		return err
+	}
+
+	for _, entry := range entries {
+		if slices.Contains(keep, entry.Name()) {
+			continue
+		}
+		if err := os.RemoveAll(filepath.Join(path, entry.Name())); err != nil {
+			log.Warn().Msgf("Error deleting %s in event folder: %s with error: %s", entry.Name(), path, err)
+			return err
+		}
+	}
+	return nil
+}
+
 func CreateUserFolder(teamId string, eventTag string) error {
 	path := FileTransferRoot + "/" + eventTag + "/" + teamId
 	if _, err := os.Stat(path); !os.IsNotExist(err) {
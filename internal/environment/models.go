@@ -3,6 +3,7 @@ package environment
 import (
 	"net/http"
 	"sync"
+	"time"
 
 	wgproto "github.com/aau-network-security/gwireguard/proto" //v1.0.3
 	"github.com/aau-network-security/haaukins-agent/internal/environment/lab"
@@ -31,6 +32,7 @@ type Environment struct {
 	GuacUserStore *GuacUserStore
 	Dockerhost    virtual.Host
 	Labs          map[string]*lab.Lab
+	Spectators    map[string]*Spectator
 	// Fill out rest when starting to make labs
 }
 
@@ -53,6 +55,8 @@ type EnvConfig struct {
 	WorkerPool      worker.WorkerPool
 	LabConf         lab.LabConf
 	Status          Status
+	RecordSessions  bool
+	DesktopPolicy   virtual.DesktopPolicy
 }
 
 type Category struct {
@@ -79,6 +83,7 @@ type IpRules struct {
 
 // Guac types
 type Guacamole struct {
+	EventTag   string
 	Client     *http.Client
 	Token      string
 	Port       uint
@@ -130,6 +135,8 @@ type createRDPConnConf struct {
 	DestPort                 *uint   `json:"dest-port"`
 	DisableAudio             *bool   `json:"disable-audio"`
 	DisableAuth              *bool   `json:"disable-auth"`
+	DisableCopy              *bool   `json:"disable-copy,omitempty"`
+	DisablePaste             *bool   `json:"disable-paste,omitempty"`
 	DPI                      *uint   `json:"dpi"`
 	EnableAudio              *bool   `json:"enable-audio"`
 	EnableAudioInput         *bool   `json:"enable-audio-input"`
@@ -158,6 +165,9 @@ type createRDPConnConf struct {
 	SwapRedBlue              *bool   `json:"swap-red-blue"`
 	CreateDrivePath          *bool   `json:"create-drive-path"`
 	DrivePath                *string `json:"drive-path"`
+	RecordingPath            *string `json:"recording-path,omitempty"`
+	RecordingName            *string `json:"recording-name,omitempty"`
+	CreateRecordingPath      *bool   `json:"create-recording-path,omitempty"`
 	Username                 *string `json:"username,omitempty"`
 	Password                 *string `json:"password,omitempty"`
 }
@@ -177,4 +187,19 @@ type CreateRDPConnOpts struct {
 	EnableDrive      *bool
 	CreateDrivePath  *bool
 	DrivePath        *string
+	RecordingPath    *string
+	RecordingName    *string
+	ReadOnly         *bool
+	DisableCopy      *bool
+	DisablePaste     *bool
+	DisableAudio     *bool
+}
+
+// A temporary Guacamole user with read-only access to the frontends of a lab
+type Spectator struct {
+	Username      string
+	Password      string
+	LabTag        string
+	ConnectionIds []string
+	ExpiresAt     time.Time
 }
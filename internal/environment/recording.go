@@ -0,0 +1,165 @@
+package environment
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/aau-network-security/haaukins-agent/internal/environment/lab/virtual"
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	// Name of the folder inside the event folder where session recordings are stored.
+	// The event folder is mounted into guacd as /home/, so guacd writes recordings to /home/recordings/<labTag>
+	RecordingsFolder = "recordings"
+	// Guacamole replaces the tokens when the session starts, giving every session its own recording file
+	recordingNamePrefix = "${GUAC_DATE}-${GUAC_TIME}-"
+	guacencBin          = "guacenc"
+	guacencSuffix       = ".m4v"
+)
+
+var (
+	GuacencNotAvailableErr = errors.New("guacenc is not available on this agent")
+	InvalidRecordingErr    = errors.New("invalid recording name")
+)
+
+type Recording struct {
+	LabTag  string
+	Name    string
+	Size    int64
+	ModTime time.Time
+}
+
+// Path of the recordings for a lab as seen from inside the guacd container
+func guacRecordingPath(labTag string) string {
+	return "/home/" + RecordingsFolder + "/" + labTag
+}
+
+// Path of the recordings for an event on the host
+func RecordingsDir(eventTag string) string {
+	return filepath.Join(virtual.FileTransferRoot, eventTag, RecordingsFolder)
+}
+
+// Lists the session recordings stored for an event. If labTag is not empty, only recordings for that lab are listed.
+// Recordings are read directly from disk so they are still available after the environment has been closed.
+func ListRecordings(eventTag, labTag string) ([]Recording, error) {
+	if err := validatePathElem(eventTag); err != nil {
+		return nil, err
+	}
+
+	labDirs, err := os.ReadDir(RecordingsDir(eventTag))
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	var recordings []Recording
+	for _, labDir := range labDirs {
+		if !labDir.IsDir() || (labTag != "" && labDir.Name() != labTag) {
+			continue
+		}
+
+		files, err := os.ReadDir(filepath.Join(RecordingsDir(eventTag), labDir.Name()))
+		if err != nil {
+			log.Warn().Err(err).Str("labTag", labDir.Name()).Msg("error reading recordings for lab")
+			continue
+		}
+
+		for _, f := range files {
+			info, err := f.Info()
+			if err != nil || info.IsDir() {
+				continue
+			}
+			recordings = append(recordings, Recording{
+				LabTag:  labDir.Name(),
+				Name:    f.Name(),
+				Size:    info.Size(),
+				ModTime: info.ModTime(),
+			})
+		}
+	}
+
+	sort.Slice(recordings, func(i, j int) bool {
+		return recordings[i].ModTime.Before(recordings[j].ModTime)
+	})
+
+	return recordings, nil
+}
+
+// Returns the path on the host to a specific recording.
+// Since the event tag, lab tag and name comes from the daemon, they are validated to not escape the recordings folder.
+func GetRecordingPath(eventTag, labTag, name string) (string, error) {
+	for _, elem := range []string{eventTag, labTag, name} {
+		if err := validatePathElem(elem); err != nil {
+			return "", err
+		}
+	}
+
+	path := filepath.Join(RecordingsDir(eventTag), labTag, name)
+	info, err := os.Stat(path)
+	if err != nil {
+		return "", fmt.Errorf("could not find recording %s for lab %s: %w", name, labTag, err)
+	}
+	if info.IsDir() {
+		return "", InvalidRecordingErr
+	}
+
+	return path, nil
+}
+
+// Returns true if guacenc is installed, so recordings can be converted
+func GuacencAvailable() bool {
+	_, err := exec.LookPath(guacencBin)
+	return err == nil
+}
+
+// Converts a Guacamole session recording into a video using guacenc.
+// guacenc writes the video next to the recording with the .m4v suffix.
+func ConvertRecording(eventTag, labTag, name string) (Recording, error) {
+	if strings.HasSuffix(name, guacencSuffix) {
+		return Recording{}, fmt.Errorf("%w: recording is already converted", InvalidRecordingErr)
+	}
+
+	guacenc, err := exec.LookPath(guacencBin)
+	if err != nil {
+		return Recording{}, GuacencNotAvailableErr
+	}
+
+	path, err := GetRecordingPath(eventTag, labTag, name)
+	if err != nil {
+		return Recording{}, err
+	}
+
+	out, err := exec.Command(guacenc, "-f", path).CombinedOutput()
+	if err != nil {
+		log.Error().Err(err).Str("output", string(out)).Msg("error converting recording with guacenc")
+		return Recording{}, fmt.Errorf("error converting recording: %v", err)
+	}
+
+	info, err := os.Stat(path + guacencSuffix)
+	if err != nil {
+		return Recording{}, err
+	}
+
+	return Recording{
+		LabTag:  labTag,
+		Name:    name + guacencSuffix,
+		Size:    info.Size(),
+		ModTime: info.ModTime(),
+	}, nil
+}
+
+func validatePathElem(elem string) error {
+	if elem == "" || elem == "." || elem == ".." || strings.ContainsAny(elem, `/\`) {
+		return InvalidRecordingErr
+	}
+	return nil
+}
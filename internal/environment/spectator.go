@@ -0,0 +1,99 @@
+package environment
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aau-network-security/haaukins-agent/internal/environment/lab"
+	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
+)
+
+// Creates a temporary Guacamole user with read-only RDP connections to every frontend in the lab.
+// Since VRDE multicon is enabled on the frontends, the spectator can watch the team without taking control.
+// The spectator is removed again by RemoveExpiredSpectators when it expires.
+func (env *Environment) CreateSpectator(ctx context.Context, l *lab.Lab, expiry time.Duration) (*Spectator, error) {
+	rdpPorts := l.RdpConnPorts()
+	if len(rdpPorts) == 0 {
+		return nil, NoFrontendsErr
+	}
+
+	hostIp, err := env.Dockerhost.GetDockerHostIP()
+	if err != nil {
+		return nil, err
+	}
+
+	spectator := &Spectator{
+		Username:  "spectator-" + uuid.New().String()[0:8],
+		Password:  uuid.New().String(),
+		LabTag:    l.Tag,
+		ExpiresAt: time.Now().Add(expiry),
+	}
+
+	if err := env.Guac.CreateUser(ctx, spectator.Username, spectator.Password); err != nil {
+		log.Error().Err(err).Str("labTag", l.Tag).Msg("error creating guacamole user for spectator")
+		return nil, err
+	}
+
+	readOnly := true
+	width, height, depth := env.EnvConfig.DesktopPolicy.VideoMode()
+	for i, port := range rdpPorts {
+		name := fmt.Sprintf("%s-client%d", spectator.Username, i+1)
+		id, err := env.Guac.CreateRDPConn(ctx, CreateRDPConnOpts{
+			Host:             hostIp,
+			Port:             port,
+			Name:             name,
+			GuacUser:         spectator.Username,
+			ReadOnly:         &readOnly,
+			ResolutionWidth:  width,
+			ResolutionHeight: height,
+			ColorDepth:       depth,
+		})
+		if err != nil {
+			log.Error().Err(err).Str("labTag", l.Tag).Msg("error creating read-only connection for spectator")
+			env.removeSpectator(spectator)
+			return nil, err
+		}
+		spectator.ConnectionIds = append(spectator.ConnectionIds, id)
+	}
+
+	env.M.Lock()
+	env.Spectators[spectator.Username] = spectator
+	env.M.Unlock()
+
+	return spectator, nil
+}
+
+// Removes all spectators which have expired from guacamole and the environment.
+// Returns the amount of spectators removed.
+func (env *Environment) RemoveExpiredSpectators() int {
+	var expired []*Spectator
+	env.M.Lock()
+	for username, spectator := range env.Spectators {
+		if time.Now().After(spectator.ExpiresAt) {
+			expired = append(expired, spectator)
+			delete(env.Spectators, username)
+		}
+	}
+	env.M.Unlock()
+
+	for _, spectator := range expired {
+		log.Info().Str("labTag", spectator.LabTag).Str("spectator", spectator.Username).Msg("removing expired spectator")
+		env.removeSpectator(spectator)
+	}
+
+	return len(expired)
+}
+
+func (env *Environment) removeSpectator(spectator *Spectator) {
+	for _, id := range spectator.ConnectionIds {
+		if err := env.Guac.DeleteConnection(id); err != nil {
+			log.Warn().Err(err).Str("connectionId", id).Msg("error deleting spectator connection")
+		}
+	}
+
+	if err := env.Guac.DeleteUser(spectator.Username); err != nil {
+		log.Warn().Err(err).Str("spectator", spectator.Username).Msg("error deleting spectator user")
+	}
+}
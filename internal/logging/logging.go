@@ -0,0 +1,33 @@
+package logging
+
+import (
+	"context"
+
+	"github.com/rs/zerolog"
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	EnvTag      = "envTag"
+	LabTag      = "labTag"
+	ExerciseTag = "exerciseTag"
+)
+
+// Returns the logger of ctx, or the global logger if ctx does not have one
+func Ctx(ctx context.Context) *zerolog.Logger {
+	if l := zerolog.Ctx(ctx); l.GetLevel() != zerolog.Disabled {
+		return l
+	}
+	return &log.Logger
+}
+
+// Returns a context with a child logger of the logger of ctx, which adds the key and value to every message
+func With(ctx context.Context, key, value string) context.Context {
+	l := Ctx(ctx).With().Str(key, value).Logger()
+	return l.WithContext(ctx)
+}
+
+// Returns detached with the logger of ctx. Used together with tracing.Detach for work which outlives the call which started it.
+func Detach(ctx, detached context.Context) context.Context {
+	return Ctx(ctx).WithContext(detached)
+}
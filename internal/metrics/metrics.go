@@ -0,0 +1,80 @@
+// Prometheus metrics of the agent. Metrics concerning an event are labelled by the event tag,
+// so the load of each event can be followed on its own.
+package metrics
+
+import (
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+const namespace = "haaukins_agent"
+
+// Stages of creating a lab, used as the stage label of LabCreationDuration
+const (
+	StageNetwork   = "network"
+	StageExercises = "exercises"
+	StageFrontends = "frontends"
+	StageStart     = "start"
+	StageGuac      = "guac"
+	StageVPN       = "vpn"
+	StageTotal     = "total"
+)
+
+var (
+	LabCreationDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "lab_creation_duration_seconds",
+		Help:      "Time spent creating labs by stage.",
+		Buckets:   prometheus.ExponentialBuckets(0.5, 2, 12),
+	}, []string{"event", "stage"})
+
+	TaskWaitDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "task_wait_seconds",
+		Help:      "Time tasks spent queued before a worker took them.",
+		Buckets:   prometheus.ExponentialBuckets(0.1, 2, 14),
+	}, []string{"event", "task"})
+
+	TaskDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "task_duration_seconds",
+		Help:      "Time tasks spent running, including retries, by the state they finished in.",
+		Buckets:   prometheus.ExponentialBuckets(0.5, 2, 12),
+	}, []string{"event", "task", "state"})
+
+	GuacAPIErrors = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "guac_api_errors_total",
+		Help:      "Failed calls to the Guacamole API.",
+	}, []string{"event", "action"})
+
+	IptablesFailures = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "iptables_failures_total",
+		Help:      "Failed iptables commands.",
+	}, []string{"event"})
+
+	StateSaveDuration = promauto.NewHistogram(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "state_save_duration_seconds",
+		Help:      "Time spent saving the state file.",
+		Buckets:   prometheus.ExponentialBuckets(0.001, 2, 14),
+	})
+)
+
+// Observes the time since start for a stage of creating a lab for an event
+func ObserveLabStage(event, stage string, start time.Time) {
+	LabCreationDuration.WithLabelValues(event, stage).Observe(time.Since(start).Seconds())
+}
+
+// Removes the series of a closed event, so past events do not keep stale series
+func DeleteEvent(event string) {
+	labels := prometheus.Labels{"event": event}
+	LabCreationDuration.DeletePartialMatch(labels)
+	TaskWaitDuration.DeletePartialMatch(labels)
+	TaskDuration.DeletePartialMatch(labels)
+	GuacAPIErrors.DeletePartialMatch(labels)
+	IptablesFailures.DeletePartialMatch(labels)
+}
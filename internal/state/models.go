@@ -15,12 +15,13 @@ import (
 // So the state variables will be carefully choosen in order to resume the state most effectively.
 
 type Environment struct {
-	EnvConfig EnvConfig
-	Guac      Guacamole
-	IpT       IPTables
-	IpRules   map[string]env.IpRules
-	IpAddrs   [][]int
-	Labs      map[string]Lab
+	EnvConfig  EnvConfig
+	Guac       Guacamole
+	IpT        IPTables
+	IpRules    map[string]env.IpRules
+	IpAddrs    [][]int
+	Labs       map[string]Lab
+	Spectators map[string]env.Spectator
 }
 
 type EnvConfig struct {
@@ -32,6 +33,8 @@ type EnvConfig struct {
 	LabConf         LabConf
 	Status          env.Status
 	TeamSize        int
+	RecordSessions  bool
+	DesktopPolicy   virtual.DesktopPolicy
 }
 
 type Lab struct {
@@ -49,7 +52,10 @@ type Lab struct {
 	IsVPN             bool
 	GuacUsername      string
 	GuacPassword      string
+	Members           []lab.Member
+	ExposedServices   []lab.ExposedService
 	VpnConfs          []string
+	DesktopPolicy     virtual.DesktopPolicy
 }
 
 type LabConf struct {
@@ -104,5 +110,5 @@ type IPTables struct {
 }
 
 type State struct {
-	Environments map[string]Environment `json:"environments`
+	Environments map[string]Environment `json:"environments"`
 }
@@ -13,14 +13,17 @@ import (
 	"github.com/aau-network-security/haaukins-agent/internal/environment/lab/exercise"
 	wg "github.com/aau-network-security/haaukins-agent/internal/environment/lab/network/vpn"
 	"github.com/aau-network-security/haaukins-agent/internal/environment/lab/virtual"
+	"github.com/aau-network-security/haaukins-agent/internal/metrics"
 	"github.com/aau-network-security/haaukins-agent/internal/worker"
 	"github.com/goccy/go-json"
+	"github.com/prometheus/client_golang/prometheus"
 	"github.com/rs/zerolog/log"
 )
 
 // Saves the current state of the environment pool to a state.json file.
 // Should be called whenever any changes to the environment pool is made
 func SaveState(envPool *environment.EnvPool, statePath string) error {
+	defer prometheus.NewTimer(metrics.StateSaveDuration).ObserveDuration()
 	envPool.M.RLock()
 	defer envPool.M.RUnlock()
 	state := State{
@@ -95,9 +98,10 @@ func ResumeState(vlib *virtual.VboxLibrary, workerPool worker.WorkerPool, stateP
 // Converts the environment state (state.Environment) from the state.json file into the type environment.Environment to be inserted to the environment pool
 func convertEnvState(envState Environment, vlib *virtual.VboxLibrary, workerPool worker.WorkerPool) (*environment.Environment, error) {
 	env := &environment.Environment{
-		M:       &sync.RWMutex{},
-		IpRules: envState.IpRules,
-		Labs:    make(map[string]*lab.Lab),
+		M:          &sync.RWMutex{},
+		IpRules:    envState.IpRules,
+		Labs:       make(map[string]*lab.Lab),
+		Spectators: make(map[string]*environment.Spectator),
 	}
 	env.EnvConfig = &environment.EnvConfig{
 		Tag:             envState.EnvConfig.Tag,
@@ -106,12 +110,15 @@ func convertEnvState(envState Environment, vlib *virtual.VboxLibrary, workerPool
 		VPNEndpointPort: envState.EnvConfig.VPNEndpointPort,
 		VpnConfig:       envState.EnvConfig.VpnConfig,
 		TeamSize:        envState.EnvConfig.TeamSize,
+		RecordSessions:  envState.EnvConfig.RecordSessions,
+		DesktopPolicy:   envState.EnvConfig.DesktopPolicy,
 		WorkerPool:      workerPool,
 		LabConf: lab.LabConf{
 			Vlib:              vlib,
 			Frontends:         envState.EnvConfig.LabConf.Frontends,
 			ExerciseConfs:     envState.EnvConfig.LabConf.ExerciseConfs,
 			DisabledExercises: envState.EnvConfig.LabConf.DisabledExercises,
+			DesktopPolicy:     envState.EnvConfig.DesktopPolicy,
 		},
 		Status: envState.EnvConfig.Status,
 	}
@@ -126,6 +133,7 @@ func convertEnvState(envState Environment, vlib *virtual.VboxLibrary, workerPool
 	}
 
 	env.Guac = environment.Guacamole{
+		EventTag:   env.EnvConfig.Tag,
 		Client:     client,
 		Token:      envState.Guac.Token,
 		Port:       envState.Guac.Port,
@@ -134,6 +142,7 @@ func convertEnvState(envState Environment, vlib *virtual.VboxLibrary, workerPool
 	}
 
 	env.IpT = environment.IPTables{
+		EventTag: env.EnvConfig.Tag,
 		Sudo:     envState.IpT.Sudo,
 		Flags:    envState.IpT.Flags,
 		Debug:    env.IpT.Debug,
@@ -152,6 +161,11 @@ func convertEnvState(envState Environment, vlib *virtual.VboxLibrary, workerPool
 
 	env.GuacUserStore = environment.NewGuacUserStore()
 
+	for k, s := range envState.Spectators {
+		spectator := s
+		env.Spectators[k] = &spectator
+	}
+
 	env.Dockerhost = virtual.NewHost()
 
 	for k, l := range envState.Labs {
@@ -214,7 +228,10 @@ func convertLabState(l Lab, vlib *virtual.VboxLibrary) (*lab.Lab, error) {
 	resumedLab.IsVPN = l.IsVPN
 	resumedLab.GuacUsername = l.GuacUsername
 	resumedLab.GuacPassword = l.GuacPassword
+	resumedLab.Members = l.Members
+	resumedLab.ExposedServices = l.ExposedServices
 	resumedLab.VpnConfs = l.VpnConfs
+	resumedLab.DesktopPolicy = l.DesktopPolicy
 
 	return resumedLab, nil
 }
@@ -222,8 +239,9 @@ func convertLabState(l Lab, vlib *virtual.VboxLibrary) (*lab.Lab, error) {
 // Takes an environment from the environment pool and makes it into a serializable state.Environment object
 func makeEnvState(env *environment.Environment) Environment {
 	envState := Environment{
-		IpRules: make(map[string]environment.IpRules),
-		Labs:    make(map[string]Lab),
+		IpRules:    make(map[string]environment.IpRules),
+		Labs:       make(map[string]Lab),
+		Spectators: make(map[string]environment.Spectator),
 	}
 	envState.EnvConfig = EnvConfig{
 		Tag:             env.EnvConfig.Tag,
@@ -232,6 +250,8 @@ func makeEnvState(env *environment.Environment) Environment {
 		VPNEndpointPort: env.EnvConfig.VPNEndpointPort,
 		VpnConfig:       env.EnvConfig.VpnConfig,
 		TeamSize:        env.EnvConfig.TeamSize,
+		RecordSessions:  env.EnvConfig.RecordSessions,
+		DesktopPolicy:   env.EnvConfig.DesktopPolicy,
 		LabConf: LabConf{
 			Frontends:         env.EnvConfig.LabConf.Frontends,
 			ExerciseConfs:     env.EnvConfig.LabConf.ExerciseConfs,
@@ -251,6 +271,10 @@ func makeEnvState(env *environment.Environment) Environment {
 		envState.Guac.Containers[k] = c
 	}
 
+	for k, s := range env.Spectators {
+		envState.Spectators[k] = *s
+	}
+
 	envState.IpAddrs = env.IpAddrs
 	envState.IpRules = env.IpRules
 	envState.IpT = IPTables{
@@ -312,7 +336,10 @@ func makeLabState(l *lab.Lab) Lab {
 	labState.IsVPN = l.IsVPN
 	labState.GuacUsername = l.GuacUsername
 	labState.GuacPassword = l.GuacPassword
+	labState.Members = l.Members
+	labState.ExposedServices = l.ExposedServices
 	labState.VpnConfs = l.VpnConfs
+	labState.DesktopPolicy = l.DesktopPolicy
 
 	return labState
 }
@@ -0,0 +1,38 @@
+package tracing
+
+import (
+	"context"
+	"crypto/tls"
+	"os"
+	"strings"
+
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+)
+
+// Returns an exporter sending spans to the OTLP/HTTP endpoint of a collector, like "localhost:4318"
+// or a full URL. TLS is used unless insecure is set or the URL is http.
+func NewOTLPExporter(ctx context.Context, endpoint string, insecure bool, tlsConfig *tls.Config) (sdktrace.SpanExporter, error) {
+	var opts []otlptracehttp.Option
+	if strings.HasPrefix(endpoint, "http://") || strings.HasPrefix(endpoint, "https://") {
+		opts = append(opts, otlptracehttp.WithEndpointURL(endpoint))
+	} else {
+		opts = append(opts, otlptracehttp.WithEndpoint(endpoint))
+	}
+	if insecure {
+		opts = append(opts, otlptracehttp.WithInsecure())
+	} else if tlsConfig != nil {
+		opts = append(opts, otlptracehttp.WithTLSClientConfig(tlsConfig))
+	}
+	return otlptracehttp.New(ctx, opts...)
+}
+
+// Returns an exporter appending spans to a file as JSON, one span per line
+func NewFileExporter(path string) (sdktrace.SpanExporter, error) {
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0644)
+	if err != nil {
+		return nil, err
+	}
+	return stdouttrace.New(stdouttrace.WithWriter(f))
+}
@@ -0,0 +1,133 @@
+// OpenTelemetry tracing of the agent. Spans are started around the slow parts of creating labs,
+// like pulling images, importing VMs and calling the Guacamole API, and are exported to an OTLP
+// collector or a file. Trace context is taken from the metadata of incoming gRPC calls,
+// so the spans of the agent become part of the trace started by the daemon.
+package tracing
+
+import (
+	"context"
+	"strings"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.12.0"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc/metadata"
+)
+
+const (
+	instrumentationName = "github.com/aau-network-security/haaukins-agent"
+	serviceName         = "haaukins-agent"
+)
+
+// Spans are started from the global tracer provider, which does nothing until Setup is called
+var tracer = otel.Tracer(instrumentationName)
+
+var propagator = propagation.NewCompositeTextMapPropagator(propagation.TraceContext{}, propagation.Baggage{})
+
+// Sets up tracing with spans exported by the exporter. Only a ratio of the traces started by the agent are sampled,
+// while traces started by the daemon are sampled if the daemon sampled them.
+func Setup(exporter sdktrace.SpanExporter, sampleRatio float64, version string) {
+	res := resource.NewWithAttributes(semconv.SchemaURL,
+		semconv.ServiceNameKey.String(serviceName),
+		semconv.ServiceVersionKey.String(version),
+	)
+
+	provider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(res),
+		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(sampleRatio))),
+	)
+	otel.SetTracerProvider(provider)
+	otel.SetTextMapPropagator(propagator)
+}
+
+// Starts a span as a child of the span in the context
+func Start(ctx context.Context, name string, attrs ...attribute.KeyValue) (context.Context, trace.Span) {
+	return tracer.Start(ctx, name, trace.WithAttributes(attrs...))
+}
+
+// Starts a span for a call to the agent
+func StartServer(ctx context.Context, name string, attrs ...attribute.KeyValue) (context.Context, trace.Span) {
+	return tracer.Start(ctx, name, trace.WithAttributes(attrs...), trace.WithSpanKind(trace.SpanKindServer))
+}
+
+// Ends the span, marking it as failed if err is not nil
+func End(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
+
+// Returns a context which is not canceled with ctx, but continues the trace of ctx.
+// Used for work which outlives the call which started it, like tasks in the worker pool.
+func Detach(ctx context.Context) context.Context {
+	return trace.ContextWithSpanContext(context.Background(), trace.SpanContextFromContext(ctx))
+}
+
+// Returns a context with the trace context found in the incoming gRPC metadata of ctx
+func Extract(ctx context.Context) context.Context {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ctx
+	}
+	return propagator.Extract(ctx, metadataCarrier(md))
+}
+
+// Adds the trace context of ctx to the outgoing gRPC metadata, so services called by the agent continue the trace
+func Inject(ctx context.Context) context.Context {
+	md, ok := metadata.FromOutgoingContext(ctx)
+	if ok {
+		md = md.Copy()
+	} else {
+		md = metadata.MD{}
+	}
+	propagator.Inject(ctx, metadataCarrier(md))
+	return metadata.NewOutgoingContext(ctx, md)
+}
+
+// Lets the propagator read and write gRPC metadata
+type metadataCarrier metadata.MD
+
+func (c metadataCarrier) Get(key string) string {
+	values := metadata.MD(c).Get(key)
+	if len(values) == 0 {
+		return ""
+	}
+	return values[0]
+}
+
+func (c metadataCarrier) Set(key, value string) {
+	metadata.MD(c).Set(key, value)
+}
+
+func (c metadataCarrier) Keys() []string {
+	keys := make([]string, 0, len(c))
+	for k := range c {
+		keys = append(keys, strings.ToLower(k))
+	}
+	return keys
+}
+
+// Attributes used on the spans of the agent
+func EventTag(tag string) attribute.KeyValue {
+	return attribute.String("haaukins.event", tag)
+}
+
+func LabTag(tag string) attribute.KeyValue {
+	return attribute.String("haaukins.lab", tag)
+}
+
+func ExerciseTag(tag string) attribute.KeyValue {
+	return attribute.String("haaukins.exercise", tag)
+}
+
+func Image(image string) attribute.KeyValue {
+	return attribute.String("haaukins.image", image)
+}
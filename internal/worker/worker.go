@@ -1,43 +1,498 @@
 package worker
 
-import "github.com/rs/zerolog/log"
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/aau-network-security/haaukins-agent/internal/logging"
+	"github.com/aau-network-security/haaukins-agent/internal/metrics"
+	"github.com/aau-network-security/haaukins-agent/internal/tracing"
+	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+const (
+	DefaultQueueSize = 200
+
+	// Finished tasks are kept so they can be listed, until there are more than this
+	maxFinishedTasks = 1000
+)
+
+var QueueFullErr = errors.New("task queue is full")
+
+// Priority of a task. Workers always take the task with the highest priority first,
+// so teams waiting for their lab are not stuck behind bulk provisioning of an event.
+// Bulk is the zero value, so tasks which do not set a priority cannot jump the queue.
+type Priority uint8
+
+const (
+	// Tasks for many labs at once, like creating the initial labs of an event
+	PriorityBulk Priority = iota
+	// Tasks freeing resources, like closing labs
+	PriorityTeardown
+	// Tasks a team is waiting for, like creating a lab for a team
+	PriorityInteractive
+
+	priorityCount = 3
+)
+
+func (p Priority) String() string {
+	switch p {
+	case PriorityBulk:
+		return "bulk"
+	case PriorityTeardown:
+		return "teardown"
+	case PriorityInteractive:
+		return "interactive"
+	}
+	return "unknown"
+}
+
+type TaskState uint8
+
+const (
+	TaskQueued TaskState = iota
+	TaskRunning
+	TaskDone
+	TaskFailed
+	TaskCanceled
+	// Waiting to be tried again after a transient error
+	TaskRetrying
+)
+
+func (s TaskState) String() string {
+	switch s {
+	case TaskQueued:
+		return "queued"
+	case TaskRunning:
+		return "running"
+	case TaskDone:
+		return "done"
+	case TaskFailed:
+		return "failed"
+	case TaskCanceled:
+		return "canceled"
+	case TaskRetrying:
+		return "retrying"
+	}
+	return "unknown"
+}
+
+// Describes a task when it is added to the pool
+type TaskOpts struct {
+	Name     string
+	EnvTag   string
+	LabTag   string
+	Priority Priority
+	Retry    RetryPolicy
+}
+
+// Retries a task which fails with a retryable error, with exponential backoff between attempts.
+// The zero value runs the task once.
+type RetryPolicy struct {
+	MaxAttempts    int
+	InitialBackoff time.Duration
+	MaxBackoff     time.Duration
+	Retryable      func(err error) bool
+}
+
+// Returns the time to wait after a failed attempt
+func (p RetryPolicy) backoff(attempt int) time.Duration {
+	backoff := p.InitialBackoff
+	for i := 1; i < attempt; i++ {
+		backoff *= 2
+		if p.MaxBackoff > 0 && backoff >= p.MaxBackoff {
+			return p.MaxBackoff
+		}
+	}
+	return backoff
+}
+
+func (p RetryPolicy) retry(err error, attempt int) bool {
+	return attempt < p.MaxAttempts && p.Retryable != nil && p.Retryable(err)
+}
+
+type attemptKey struct{}
+
+// Returns the attempt of the task the context belongs to, starting from 1
+func Attempt(ctx context.Context) int {
+	attempt, ok := ctx.Value(attemptKey{}).(int)
+	if !ok {
+		return 1
+	}
+	return attempt
+}
+
+// Snapshot of a task in the pool
+type TaskInfo struct {
+	TaskOpts
+	ID         string
+	State      TaskState
+	Err        error
+	Attempts   int
+	CreatedAt  time.Time
+	StartedAt  time.Time
+	FinishedAt time.Time
+}
+
+// A task in the pool. The context of the task is canceled if the task is canceled while it is running.
+type Task struct {
+	info   TaskInfo
+	run    func(ctx context.Context) error
+	ctx    context.Context
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+func (t *Task) ID() string {
+	return t.info.ID
+}
+
+// Closed when the task has finished, or has been canceled before it was run
+func (t *Task) Done() <-chan struct{} {
+	return t.done
+}
+
+// Returns the error the task finished with. Must only be called after Done is closed
+func (t *Task) Err() error {
+	return t.info.Err
+}
 
 // WorkerPool is a contract for Worker Pool implementation
 type WorkerPool interface {
 	Run()
-	AddTask(task func())
+	AddTask(ctx context.Context, opts TaskOpts, run func(ctx context.Context) error) (*Task, error)
+	CancelEnvTasks(envTag string) int
+	ListTasks(envTag string) []TaskInfo
 	GetAmountOfQueuedTasks() uint32
+	GetQueuedTasksByEnv() map[string]uint32
+}
+
+// Tasks of a priority, queued per environment. Environments take turns, so an event
+// queueing hundreds of labs does not keep the workers from the labs of other events.
+type envQueues struct {
+	tasks map[string][]*Task
+	order []string // Environments with queued tasks in the order they take turns
+	next  int
+	count int
 }
 
 type workerPool struct {
-	maxWorkers  int
-	queuedTasks chan func()
+	m          sync.Mutex
+	cond       *sync.Cond
+	maxWorkers int
+	maxPerEnv  int // Maximum tasks running at once for an environment, 0 for no limit
+	queueSize  int // Maximum queued tasks of each priority
+	queues     [priorityCount]*envQueues
+	running    map[string]int
+	tasks      map[string]*Task
+	finished   []string // IDs of finished tasks, oldest first
+	// Closed and replaced whenever a task is taken from a queue, to wake up callers waiting for room
+	taken chan struct{}
 }
 
-func NewWorkerPool(maxWorkers int) WorkerPool {
-	queuedTasks := make(chan func(), 200)
-	return &workerPool{
-		maxWorkers:  maxWorkers,
-		queuedTasks: queuedTasks,
+func NewWorkerPool(maxWorkers, queueSize, maxPerEnv int) WorkerPool {
+	if queueSize <= 0 {
+		queueSize = DefaultQueueSize
+	}
+	wp := &workerPool{
+		maxWorkers: maxWorkers,
+		maxPerEnv:  maxPerEnv,
+		queueSize:  queueSize,
+		running:    make(map[string]int),
+		tasks:      make(map[string]*Task),
+		taken:      make(chan struct{}),
+	}
+	wp.cond = sync.NewCond(&wp.m)
+	for i := range wp.queues {
+		wp.queues[i] = &envQueues{tasks: make(map[string][]*Task)}
 	}
+	return wp
 }
 func (wp *workerPool) Run() {
 	for i := 0; i < wp.maxWorkers; i++ {
 		log.Debug().Int("workerId", i+1).Msg("starting worker")
 		go func(workerID int) {
-			for task := range wp.queuedTasks {
-				log.Debug().Int("workderId", workerID).Msg("worker is running task")
-				task()
-				log.Debug().Int("workderId", workerID).Msg("worker is done running task")
+			for {
+				t := wp.next()
+				logging.Ctx(t.ctx).Debug().Int("workderId", workerID).Str("taskId", t.info.ID).Str("task", t.info.Name).Msg("worker is running task")
+				err := wp.runWithRetries(t)
+				logging.Ctx(t.ctx).Debug().Int("workderId", workerID).Str("taskId", t.info.ID).Str("task", t.info.Name).Msg("worker is done running task")
+
+				wp.m.Lock()
+				wp.running[t.info.EnvTag]--
+				if wp.running[t.info.EnvTag] == 0 {
+					delete(wp.running, t.info.EnvTag)
+				}
+				state := TaskDone
+				if t.ctx.Err() != nil {
+					state = TaskCanceled
+				} else if err != nil {
+					state = TaskFailed
+				}
+				wp.finish(t, state, err)
+				// Tasks of the environment may be allowed to run again
+				wp.cond.Broadcast()
+				wp.m.Unlock()
 			}
 		}(i + 1)
 	}
 }
 
+// Runs a task until it succeeds, fails with an error which should not be retried, or runs out of attempts
+func (wp *workerPool) runWithRetries(t *Task) error {
+	for attempt := 1; ; attempt++ {
+		wp.m.Lock()
+		t.info.Attempts = attempt
+		t.info.State = TaskRunning
+		wp.m.Unlock()
+
+		ctx, span := tracing.Start(context.WithValue(t.ctx, attemptKey{}, attempt), "task "+t.info.Name,
+			tracing.EventTag(t.info.EnvTag),
+			attribute.String("task.id", t.info.ID),
+			attribute.Int("task.attempt", attempt),
+		)
+		err := t.run(ctx)
+		tracing.End(span, err)
+		if err == nil || t.ctx.Err() != nil || !t.info.Retry.retry(err, attempt) {
+			return err
+		}
+
+		backoff := t.info.Retry.backoff(attempt)
+		logging.Ctx(t.ctx).Warn().Err(err).Str("taskId", t.info.ID).Str("task", t.info.Name).Int("attempt", attempt).Dur("backoff", backoff).Msg("task failed with transient error, retrying")
+		wp.m.Lock()
+		t.info.State = TaskRetrying
+		t.info.Err = err
+		wp.m.Unlock()
+
+		select {
+		case <-t.ctx.Done():
+			return t.ctx.Err()
+		case <-time.After(backoff):
+		}
+	}
+}
+
+// Returns the next task to run, waiting until there is a task which is allowed to run
+func (wp *workerPool) next() *Task {
+	wp.m.Lock()
+	defer wp.m.Unlock()
+	for {
+		for p := len(wp.queues) - 1; p >= 0; p-- {
+			if t, ok := wp.queues[p].dequeue(wp.allowed); ok {
+				wp.running[t.info.EnvTag]++
+				t.info.State = TaskRunning
+				t.info.StartedAt = time.Now()
+				metrics.TaskWaitDuration.WithLabelValues(t.info.EnvTag, t.info.Name).Observe(t.info.StartedAt.Sub(t.info.CreatedAt).Seconds())
+				close(wp.taken)
+				wp.taken = make(chan struct{})
+				return t
+			}
+		}
+		wp.cond.Wait()
+	}
+}
+
+// Returns true if the environment is below its limit of running tasks
+func (wp *workerPool) allowed(envTag string) bool {
+	return wp.maxPerEnv <= 0 || wp.running[envTag] < wp.maxPerEnv
+}
+
+// Marks a task as finished and forgets the oldest finished tasks. Must be called with the lock held.
+func (wp *workerPool) finish(t *Task, state TaskState, err error) {
+	t.info.State = state
+	t.info.Err = err
+	t.info.FinishedAt = time.Now()
+	if !t.info.StartedAt.IsZero() {
+		metrics.TaskDuration.WithLabelValues(t.info.EnvTag, t.info.Name, state.String()).Observe(t.info.FinishedAt.Sub(t.info.StartedAt).Seconds())
+	}
+	t.cancel()
+	close(t.done)
+
+	wp.finished = append(wp.finished, t.info.ID)
+	for len(wp.finished) > maxFinishedTasks {
+		delete(wp.tasks, wp.finished[0])
+		wp.finished = wp.finished[1:]
+	}
+}
+
+// Takes the first task of the next environment in turn which is allowed to run a task
+func (q *envQueues) dequeue(allowed func(string) bool) (*Task, bool) {
+	for i := 0; i < len(q.order); i++ {
+		idx := (q.next + i) % len(q.order)
+		envTag := q.order[idx]
+		if !allowed(envTag) {
+			continue
+		}
+
+		t := q.tasks[envTag][0]
+		q.tasks[envTag] = q.tasks[envTag][1:]
+		q.count--
+		if len(q.tasks[envTag]) == 0 {
+			delete(q.tasks, envTag)
+			q.order = append(q.order[:idx], q.order[idx+1:]...)
+			q.next = idx
+		} else {
+			q.next = idx + 1
+		}
+		if q.next >= len(q.order) {
+			q.next = 0
+		}
+		return t, true
+	}
+	return nil, false
+}
+
+func (q *envQueues) enqueue(t *Task) {
+	envTag := t.info.EnvTag
+	if _, ok := q.tasks[envTag]; !ok {
+		q.order = append(q.order, envTag)
+	}
+	q.tasks[envTag] = append(q.tasks[envTag], t)
+	q.count++
+}
+
+// Removes and returns all queued tasks of an environment
+func (q *envQueues) remove(envTag string) []*Task {
+	tasks, ok := q.tasks[envTag]
+	if !ok {
+		return nil
+	}
+	delete(q.tasks, envTag)
+	q.count -= len(tasks)
+	for i, tag := range q.order {
+		if tag == envTag {
+			q.order = append(q.order[:i], q.order[i+1:]...)
+			if q.next > i {
+				q.next--
+			}
+			break
+		}
+	}
+	if q.next >= len(q.order) {
+		q.next = 0
+	}
+	return tasks
+}
+
 func (wp *workerPool) GetAmountOfQueuedTasks() uint32 {
-	return uint32(len(wp.queuedTasks))
+	wp.m.Lock()
+	defer wp.m.Unlock()
+	count := 0
+	for _, q := range wp.queues {
+		count += q.count
+	}
+	return uint32(count)
+}
+
+// Returns the amount of queued tasks of each environment with queued tasks
+func (wp *workerPool) GetQueuedTasksByEnv() map[string]uint32 {
+	wp.m.Lock()
+	defer wp.m.Unlock()
+	queued := make(map[string]uint32)
+	for _, q := range wp.queues {
+		for envTag, tasks := range q.tasks {
+			queued[envTag] += uint32(len(tasks))
+		}
+	}
+	return queued
 }
 
-func (wp *workerPool) AddTask(task func()) {
-	wp.queuedTasks <- task
+// Adds a task to the queue of its priority. If the queue is full, it waits until there is room or the context is done,
+// in which case QueueFullErr is returned so the caller can back off instead of blocking.
+// The context is only used while adding the task, the task is run with its own context continuing the trace and logger of ctx.
+func (wp *workerPool) AddTask(ctx context.Context, opts TaskOpts, run func(ctx context.Context) error) (*Task, error) {
+	if int(opts.Priority) >= priorityCount {
+		opts.Priority = PriorityBulk
+	}
+	q := wp.queues[opts.Priority]
+
+	for {
+		wp.m.Lock()
+		if q.count < wp.queueSize {
+			taskCtx, cancel := context.WithCancel(logging.Detach(ctx, tracing.Detach(ctx)))
+			t := &Task{
+				info: TaskInfo{
+					TaskOpts:  opts,
+					ID:        uuid.New().String(),
+					State:     TaskQueued,
+					CreatedAt: time.Now(),
+				},
+				run:    run,
+				ctx:    taskCtx,
+				cancel: cancel,
+				done:   make(chan struct{}),
+			}
+			wp.tasks[t.info.ID] = t
+			q.enqueue(t)
+			wp.cond.Signal()
+			wp.m.Unlock()
+			return t, nil
+		}
+		taken := wp.taken
+		count := q.count
+		wp.m.Unlock()
+
+		select {
+		case <-taken:
+		case <-ctx.Done():
+			return nil, fmt.Errorf("%w: %d %s tasks queued", QueueFullErr, count, opts.Priority)
+		}
+	}
+}
+
+// Cancels the queued and running tasks of an environment. Queued tasks are never run,
+// and running tasks have their context canceled. Teardown tasks are not canceled,
+// since they free resources which would otherwise be left behind.
+// Returns the amount of tasks canceled.
+func (wp *workerPool) CancelEnvTasks(envTag string) int {
+	wp.m.Lock()
+	defer wp.m.Unlock()
+
+	canceled := 0
+	for p, q := range wp.queues {
+		if Priority(p) == PriorityTeardown {
+			continue
+		}
+		for _, t := range q.remove(envTag) {
+			wp.finish(t, TaskCanceled, context.Canceled)
+			canceled++
+		}
+	}
+
+	for _, t := range wp.tasks {
+		running := t.info.State == TaskRunning || t.info.State == TaskRetrying
+		if t.info.EnvTag == envTag && running && t.info.Priority != PriorityTeardown {
+			t.cancel()
+			canceled++
+		}
+	}
+
+	// Removing tasks frees room in the queues
+	close(wp.taken)
+	wp.taken = make(chan struct{})
+	return canceled
+}
+
+// Returns the tasks in the pool, optionally only for an environment, in the order they were created
+func (wp *workerPool) ListTasks(envTag string) []TaskInfo {
+	wp.m.Lock()
+	defer wp.m.Unlock()
+
+	var tasks []TaskInfo
+	for _, t := range wp.tasks {
+		if envTag != "" && t.info.EnvTag != envTag {
+			continue
+		}
+		tasks = append(tasks, t.info)
+	}
+	sort.Slice(tasks, func(i, j int) bool {
+		return tasks[i].CreatedAt.Before(tasks[j].CreatedAt)
+	})
+	return tasks
 }
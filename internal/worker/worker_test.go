@@ -0,0 +1,332 @@
+package worker
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+)
+
+const testTimeout = 5 * time.Second
+
+var transientErr = errors.New("transient")
+
+// Records the order tasks run in
+type runLog struct {
+	m     sync.Mutex
+	names []string
+}
+
+func (r *runLog) task(name string) func(ctx context.Context) error {
+	return func(ctx context.Context) error {
+		r.m.Lock()
+		defer r.m.Unlock()
+		r.names = append(r.names, name)
+		return nil
+	}
+}
+
+func (r *runLog) get() []string {
+	r.m.Lock()
+	defer r.m.Unlock()
+	return append([]string(nil), r.names...)
+}
+
+func addTask(t *testing.T, wp WorkerPool, opts TaskOpts, run func(ctx context.Context) error) *Task {
+	t.Helper()
+	task, err := wp.AddTask(context.Background(), opts, run)
+	if err != nil {
+		t.Fatalf("error adding task %s: %v", opts.Name, err)
+	}
+	return task
+}
+
+func waitDone(t *testing.T, tasks ...*Task) {
+	t.Helper()
+	for _, task := range tasks {
+		select {
+		case <-task.Done():
+		case <-time.After(testTimeout):
+			t.Fatalf("timed out waiting for task %s", task.info.Name)
+		}
+	}
+}
+
+func taskInfo(t *testing.T, wp WorkerPool, task *Task) TaskInfo {
+	t.Helper()
+	for _, info := range wp.ListTasks("") {
+		if info.ID == task.ID() {
+			return info
+		}
+	}
+	t.Fatalf("task %s not found", task.ID())
+	return TaskInfo{}
+}
+
+func equal(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func TestPriorityOrder(t *testing.T) {
+	wp := NewWorkerPool(1, 0, 0)
+	var log runLog
+	tasks := []*Task{
+		addTask(t, wp, TaskOpts{Name: "bulk", EnvTag: "a", Priority: PriorityBulk}, log.task("bulk")),
+		addTask(t, wp, TaskOpts{Name: "teardown", EnvTag: "a", Priority: PriorityTeardown}, log.task("teardown")),
+		addTask(t, wp, TaskOpts{Name: "interactive", EnvTag: "a", Priority: PriorityInteractive}, log.task("interactive")),
+	}
+	wp.Run()
+	waitDone(t, tasks...)
+
+	expected := []string{"interactive", "teardown", "bulk"}
+	if got := log.get(); !equal(got, expected) {
+		t.Fatalf("expected tasks to run in order %v, got %v", expected, got)
+	}
+}
+
+func TestEnvironmentsTakeTurns(t *testing.T) {
+	wp := NewWorkerPool(1, 0, 0)
+	var log runLog
+	var tasks []*Task
+	for _, name := range []string{"a1", "a2", "a3"} {
+		tasks = append(tasks, addTask(t, wp, TaskOpts{Name: name, EnvTag: "a"}, log.task(name)))
+	}
+	for _, name := range []string{"b1", "b2"} {
+		tasks = append(tasks, addTask(t, wp, TaskOpts{Name: name, EnvTag: "b"}, log.task(name)))
+	}
+	wp.Run()
+	waitDone(t, tasks...)
+
+	expected := []string{"a1", "b1", "a2", "b2", "a3"}
+	if got := log.get(); !equal(got, expected) {
+		t.Fatalf("expected tasks to run in order %v, got %v", expected, got)
+	}
+}
+
+func TestMaxPerEnv(t *testing.T) {
+	wp := NewWorkerPool(2, 0, 1)
+	release := make(chan struct{})
+	started := make(chan string, 3)
+	blocking := func(name string) func(ctx context.Context) error {
+		return func(ctx context.Context) error {
+			started <- name
+			<-release
+			return nil
+		}
+	}
+	tasks := []*Task{
+		addTask(t, wp, TaskOpts{Name: "a1", EnvTag: "a"}, blocking("a1")),
+		addTask(t, wp, TaskOpts{Name: "a2", EnvTag: "a"}, blocking("a2")),
+		addTask(t, wp, TaskOpts{Name: "b1", EnvTag: "b"}, blocking("b1")),
+	}
+	wp.Run()
+
+	// Both workers are busy with one task of each environment, a2 waits for a1
+	running := map[string]bool{}
+	for i := 0; i < 2; i++ {
+		select {
+		case name := <-started:
+			running[name] = true
+		case <-time.After(testTimeout):
+			t.Fatal("timed out waiting for tasks to start")
+		}
+	}
+	if !running["a1"] || !running["b1"] {
+		t.Fatalf("expected a1 and b1 to run, got %v", running)
+	}
+	if info := taskInfo(t, wp, tasks[1]); info.State != TaskQueued {
+		t.Fatalf("expected a2 to be queued while a1 runs, got %s", info.State)
+	}
+
+	close(release)
+	waitDone(t, tasks...)
+}
+
+func TestCancelQueuedTasks(t *testing.T) {
+	wp := NewWorkerPool(1, 0, 0)
+	ran := make(chan string, 3)
+	run := func(name string) func(ctx context.Context) error {
+		return func(ctx context.Context) error {
+			ran <- name
+			return nil
+		}
+	}
+	create := addTask(t, wp, TaskOpts{Name: "create", EnvTag: "a", Priority: PriorityBulk}, run("create"))
+	teardown := addTask(t, wp, TaskOpts{Name: "teardown", EnvTag: "a", Priority: PriorityTeardown}, run("teardown"))
+	other := addTask(t, wp, TaskOpts{Name: "other", EnvTag: "b", Priority: PriorityBulk}, run("other"))
+
+	if canceled := wp.CancelEnvTasks("a"); canceled != 1 {
+		t.Fatalf("expected 1 canceled task, got %d", canceled)
+	}
+	waitDone(t, create)
+	if !errors.Is(create.Err(), context.Canceled) {
+		t.Fatalf("expected canceled task to finish with context.Canceled, got %v", create.Err())
+	}
+	if info := taskInfo(t, wp, create); info.State != TaskCanceled {
+		t.Fatalf("expected canceled task to be canceled, got %s", info.State)
+	}
+
+	wp.Run()
+	waitDone(t, teardown, other)
+	close(ran)
+	for name := range ran {
+		if name == "create" {
+			t.Fatal("canceled task was run")
+		}
+	}
+	if queued := wp.GetAmountOfQueuedTasks(); queued != 0 {
+		t.Fatalf("expected no queued tasks, got %d", queued)
+	}
+}
+
+func TestCancelRunningTasks(t *testing.T) {
+	wp := NewWorkerPool(2, 0, 0)
+	started := make(chan struct{}, 2)
+	wait := func(ctx context.Context) error {
+		started <- struct{}{}
+		<-ctx.Done()
+		return ctx.Err()
+	}
+	release := make(chan struct{})
+	running := addTask(t, wp, TaskOpts{Name: "create", EnvTag: "a", Priority: PriorityInteractive}, wait)
+	teardown := addTask(t, wp, TaskOpts{Name: "teardown", EnvTag: "a", Priority: PriorityTeardown}, func(ctx context.Context) error {
+		started <- struct{}{}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-release:
+			return nil
+		}
+	})
+	wp.Run()
+	for i := 0; i < 2; i++ {
+		select {
+		case <-started:
+		case <-time.After(testTimeout):
+			t.Fatal("timed out waiting for tasks to start")
+		}
+	}
+
+	if canceled := wp.CancelEnvTasks("a"); canceled != 1 {
+		t.Fatalf("expected 1 canceled task, got %d", canceled)
+	}
+	waitDone(t, running)
+	if info := taskInfo(t, wp, running); info.State != TaskCanceled {
+		t.Fatalf("expected running task to be canceled, got %s", info.State)
+	}
+
+	// Teardown tasks free resources, so they keep running
+	close(release)
+	waitDone(t, teardown)
+	if info := taskInfo(t, wp, teardown); info.State != TaskDone || info.Err != nil {
+		t.Fatalf("expected teardown task to be done, got %s: %v", info.State, info.Err)
+	}
+}
+
+func TestRetries(t *testing.T) {
+	retry := RetryPolicy{
+		MaxAttempts:    3,
+		InitialBackoff: time.Millisecond,
+		MaxBackoff:     2 * time.Millisecond,
+		Retryable:      func(err error) bool { return errors.Is(err, transientErr) },
+	}
+	tt := []struct {
+		name     string
+		retry    RetryPolicy
+		err      error
+		attempts int
+		state    TaskState
+	}{
+		{name: "retried until max attempts", retry: retry, err: transientErr, attempts: 3, state: TaskFailed},
+		{name: "permanent error is not retried", retry: retry, err: errors.New("permanent"), attempts: 1, state: TaskFailed},
+		{name: "zero policy runs once", err: transientErr, attempts: 1, state: TaskFailed},
+		{name: "success is not retried", retry: retry, attempts: 1, state: TaskDone},
+	}
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			wp := NewWorkerPool(1, 0, 0)
+			var attempts []int
+			task := addTask(t, wp, TaskOpts{Name: tc.name, EnvTag: "a", Retry: tc.retry}, func(ctx context.Context) error {
+				attempts = append(attempts, Attempt(ctx))
+				return tc.err
+			})
+			wp.Run()
+			waitDone(t, task)
+
+			info := taskInfo(t, wp, task)
+			if info.Attempts != tc.attempts || len(attempts) != tc.attempts {
+				t.Fatalf("expected %d attempts, got %d runs and %d attempts", tc.attempts, len(attempts), info.Attempts)
+			}
+			for i, attempt := range attempts {
+				if attempt != i+1 {
+					t.Fatalf("expected attempt %d, got %d", i+1, attempt)
+				}
+			}
+			if info.State != tc.state || !errors.Is(task.Err(), tc.err) {
+				t.Fatalf("expected %s with %v, got %s with %v", tc.state, tc.err, info.State, task.Err())
+			}
+		})
+	}
+}
+
+func TestCancelRetryingTask(t *testing.T) {
+	wp := NewWorkerPool(1, 0, 0)
+	failed := make(chan struct{}, 1)
+	retry := RetryPolicy{MaxAttempts: 3, InitialBackoff: time.Hour, Retryable: func(error) bool { return true }}
+	task := addTask(t, wp, TaskOpts{Name: "create", EnvTag: "a", Retry: retry}, func(ctx context.Context) error {
+		failed <- struct{}{}
+		return transientErr
+	})
+	wp.Run()
+	select {
+	case <-failed:
+	case <-time.After(testTimeout):
+		t.Fatal("timed out waiting for task to fail")
+	}
+
+	// The task waits for its backoff, and is canceled without trying again
+	for taskInfo(t, wp, task).State != TaskRetrying {
+		time.Sleep(time.Millisecond)
+	}
+	if canceled := wp.CancelEnvTasks("a"); canceled != 1 {
+		t.Fatalf("expected 1 canceled task, got %d", canceled)
+	}
+	waitDone(t, task)
+	if info := taskInfo(t, wp, task); info.State != TaskCanceled || info.Attempts != 1 {
+		t.Fatalf("expected task to be canceled after 1 attempt, got %s after %d", info.State, info.Attempts)
+	}
+}
+
+func TestBackoff(t *testing.T) {
+	p := RetryPolicy{InitialBackoff: time.Second, MaxBackoff: 5 * time.Second}
+	expected := []time.Duration{time.Second, 2 * time.Second, 4 * time.Second, 5 * time.Second, 5 * time.Second}
+	for i, backoff := range expected {
+		if got := p.backoff(i + 1); got != backoff {
+			t.Fatalf("expected backoff %s after attempt %d, got %s", backoff, i+1, got)
+		}
+	}
+}
+
+func TestQueueFull(t *testing.T) {
+	wp := NewWorkerPool(1, 1, 0)
+	noop := func(ctx context.Context) error { return nil }
+	addTask(t, wp, TaskOpts{Name: "first", EnvTag: "a"}, noop)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	if _, err := wp.AddTask(ctx, TaskOpts{Name: "second", EnvTag: "a"}, noop); !errors.Is(err, QueueFullErr) {
+		t.Fatalf("expected QueueFullErr, got %v", err)
+	}
+
+	// Each priority has its own queue
+	addTask(t, wp, TaskOpts{Name: "interactive", EnvTag: "a", Priority: PriorityInteractive}, noop)
+}
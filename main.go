@@ -11,6 +11,8 @@ import (
 	"github.com/rs/zerolog"
 	"github.com/rs/zerolog/log"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/reflection"
 )
 
 const (
@@ -23,7 +25,7 @@ var (
 )
 
 func main() {
-	zerolog.SetGlobalLevel(zerolog.DebugLevel)
+	// Logs until the logging config has been read
 	log.Logger = log.Output(zerolog.ConsoleWriter{Out: os.Stderr})
 
 	confFilePtr := flag.String("config", defaultConfigFile, "configuration file")
@@ -37,7 +39,11 @@ func main() {
 		return
 	}
 
-	a, err := agent.New(c)
+	if err := agent.SetupLogging(c.Logging); err != nil {
+		log.Fatal().Err(err).Msg("unable to set up logging")
+	}
+
+	a, err := agent.New(c, agent.BuildInfo{Version: version, CompileDate: compileDate})
 	if err != nil {
 		log.Fatal().Err(err).Msg("unable to create daemon")
 		return
@@ -50,13 +56,35 @@ func main() {
 	}
 
 	opts := []grpc.ServerOption{}
+	if tlsConfig := a.TLSConfig(); tlsConfig != nil {
+		log.Info().Bool("requireClientCert", c.TLS.RequireClientCert).Msg("serving gRPC over TLS")
+		opts = append(opts, grpc.Creds(credentials.NewTLS(tlsConfig)))
+	}
 
 	go func() {
 		a.RunGuacProxy()
 	}()
 
+	if c.MetricsPort != 0 {
+		go func() {
+			if err := a.RunMetrics(); err != nil {
+				log.Fatal().Err(err).Msg("failed to serve metrics")
+			}
+		}()
+	}
+
+	if c.Gateway.Enabled {
+		go func() {
+			if err := a.RunGateway(); err != nil {
+				log.Fatal().Err(err).Msg("failed to serve http gateway")
+			}
+		}()
+	}
+
 	gRPCServer := a.NewGRPCServer(opts...)
 	pb.RegisterAgentServer(gRPCServer, a)
+	a.RegisterHealthServer(gRPCServer)
+	reflection.Register(gRPCServer)
 	log.Info().Msg("server is waiting for clients")
 	if err := gRPCServer.Serve(lis); err != nil {
 		log.Fatal().Err(err).Msg("failed to serve")
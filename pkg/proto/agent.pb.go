@@ -63,8 +63,10 @@ type VmRequest struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	LabTag               string `protobuf:"bytes,1,opt,name=labTag,proto3" json:"labTag,omitempty"`
+	LabTag string `protobuf:"bytes,1,opt,name=labTag,proto3" json:"labTag,omitempty"`
+	// Deprecated: Do not use.
 	ConnectionIdentifier string `protobuf:"bytes,2,opt,name=connectionIdentifier,proto3" json:"connectionIdentifier,omitempty"`
+	GuacUsername         string `protobuf:"bytes,3,opt,name=guacUsername,proto3" json:"guacUsername,omitempty"`
 }
 
 func (x *VmRequest) Reset() {
@@ -106,6 +108,7 @@ func (x *VmRequest) GetLabTag() string {
 	return ""
 }
 
+// Deprecated: Do not use.
 func (x *VmRequest) GetConnectionIdentifier() string {
 	if x != nil {
 		return x.ConnectionIdentifier
@@ -113,16 +116,25 @@ func (x *VmRequest) GetConnectionIdentifier() string {
 	return ""
 }
 
-type ResetLabRequest struct {
+func (x *VmRequest) GetGuacUsername() string {
+	if x != nil {
+		return x.GuacUsername
+	}
+	return ""
+}
+
+type RecordingRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	LabTag string `protobuf:"bytes,1,opt,name=labTag,proto3" json:"labTag,omitempty"`
+	EventTag string `protobuf:"bytes,1,opt,name=eventTag,proto3" json:"eventTag,omitempty"`
+	LabTag   string `protobuf:"bytes,2,opt,name=labTag,proto3" json:"labTag,omitempty"`
+	Name     string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
 }
 
-func (x *ResetLabRequest) Reset() {
-	*x = ResetLabRequest{}
+func (x *RecordingRequest) Reset() {
+	*x = RecordingRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_agent_proto_msgTypes[2]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -130,13 +142,13 @@ func (x *ResetLabRequest) Reset() {
 	}
 }
 
-func (x *ResetLabRequest) String() string {
+func (x *RecordingRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ResetLabRequest) ProtoMessage() {}
+func (*RecordingRequest) ProtoMessage() {}
 
-func (x *ResetLabRequest) ProtoReflect() protoreflect.Message {
+func (x *RecordingRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_agent_proto_msgTypes[2]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -148,28 +160,45 @@ func (x *ResetLabRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ResetLabRequest.ProtoReflect.Descriptor instead.
-func (*ResetLabRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use RecordingRequest.ProtoReflect.Descriptor instead.
+func (*RecordingRequest) Descriptor() ([]byte, []int) {
 	return file_agent_proto_rawDescGZIP(), []int{2}
 }
 
-func (x *ResetLabRequest) GetLabTag() string {
+func (x *RecordingRequest) GetEventTag() string {
+	if x != nil {
+		return x.EventTag
+	}
+	return ""
+}
+
+func (x *RecordingRequest) GetLabTag() string {
 	if x != nil {
 		return x.LabTag
 	}
 	return ""
 }
 
-type GetLabRequest struct {
+func (x *RecordingRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type Recording struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	LabTag string `protobuf:"bytes,1,opt,name=labTag,proto3" json:"labTag,omitempty"`
+	LabTag  string `protobuf:"bytes,1,opt,name=labTag,proto3" json:"labTag,omitempty"`
+	Name    string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Size    uint64 `protobuf:"varint,3,opt,name=size,proto3" json:"size,omitempty"`
+	ModTime int64  `protobuf:"varint,4,opt,name=modTime,proto3" json:"modTime,omitempty"`
 }
 
-func (x *GetLabRequest) Reset() {
-	*x = GetLabRequest{}
+func (x *Recording) Reset() {
+	*x = Recording{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_agent_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -177,13 +206,13 @@ func (x *GetLabRequest) Reset() {
 	}
 }
 
-func (x *GetLabRequest) String() string {
+func (x *Recording) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetLabRequest) ProtoMessage() {}
+func (*Recording) ProtoMessage() {}
 
-func (x *GetLabRequest) ProtoReflect() protoreflect.Message {
+func (x *Recording) ProtoReflect() protoreflect.Message {
 	mi := &file_agent_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -195,28 +224,49 @@ func (x *GetLabRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetLabRequest.ProtoReflect.Descriptor instead.
-func (*GetLabRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use Recording.ProtoReflect.Descriptor instead.
+func (*Recording) Descriptor() ([]byte, []int) {
 	return file_agent_proto_rawDescGZIP(), []int{3}
 }
 
-func (x *GetLabRequest) GetLabTag() string {
+func (x *Recording) GetLabTag() string {
 	if x != nil {
 		return x.LabTag
 	}
 	return ""
 }
 
-type GetLabResponse struct {
+func (x *Recording) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Recording) GetSize() uint64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *Recording) GetModTime() int64 {
+	if x != nil {
+		return x.ModTime
+	}
+	return 0
+}
+
+type ListRecordingsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Lab *Lab `protobuf:"bytes,1,opt,name=lab,proto3" json:"lab,omitempty"`
+	Recordings []*Recording `protobuf:"bytes,1,rep,name=recordings,proto3" json:"recordings,omitempty"`
 }
 
-func (x *GetLabResponse) Reset() {
-	*x = GetLabResponse{}
+func (x *ListRecordingsResponse) Reset() {
+	*x = ListRecordingsResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_agent_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -224,13 +274,13 @@ func (x *GetLabResponse) Reset() {
 	}
 }
 
-func (x *GetLabResponse) String() string {
+func (x *ListRecordingsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetLabResponse) ProtoMessage() {}
+func (*ListRecordingsResponse) ProtoMessage() {}
 
-func (x *GetLabResponse) ProtoReflect() protoreflect.Message {
+func (x *ListRecordingsResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_agent_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -242,28 +292,28 @@ func (x *GetLabResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetLabResponse.ProtoReflect.Descriptor instead.
-func (*GetLabResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListRecordingsResponse.ProtoReflect.Descriptor instead.
+func (*ListRecordingsResponse) Descriptor() ([]byte, []int) {
 	return file_agent_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *GetLabResponse) GetLab() *Lab {
+func (x *ListRecordingsResponse) GetRecordings() []*Recording {
 	if x != nil {
-		return x.Lab
+		return x.Recordings
 	}
 	return nil
 }
 
-type GetHostsRequest struct {
+type RecordingChunk struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	LabTag string `protobuf:"bytes,1,opt,name=labTag,proto3" json:"labTag,omitempty"`
+	Data []byte `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
 }
 
-func (x *GetHostsRequest) Reset() {
-	*x = GetHostsRequest{}
+func (x *RecordingChunk) Reset() {
+	*x = RecordingChunk{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_agent_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -271,13 +321,13 @@ func (x *GetHostsRequest) Reset() {
 	}
 }
 
-func (x *GetHostsRequest) String() string {
+func (x *RecordingChunk) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetHostsRequest) ProtoMessage() {}
+func (*RecordingChunk) ProtoMessage() {}
 
-func (x *GetHostsRequest) ProtoReflect() protoreflect.Message {
+func (x *RecordingChunk) ProtoReflect() protoreflect.Message {
 	mi := &file_agent_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -289,28 +339,28 @@ func (x *GetHostsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetHostsRequest.ProtoReflect.Descriptor instead.
-func (*GetHostsRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use RecordingChunk.ProtoReflect.Descriptor instead.
+func (*RecordingChunk) Descriptor() ([]byte, []int) {
 	return file_agent_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *GetHostsRequest) GetLabTag() string {
+func (x *RecordingChunk) GetData() []byte {
 	if x != nil {
-		return x.LabTag
+		return x.Data
 	}
-	return ""
+	return nil
 }
 
-type GetHostsResponse struct {
+type ConvertRecordingResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Hosts []string `protobuf:"bytes,1,rep,name=hosts,proto3" json:"hosts,omitempty"`
+	Recording *Recording `protobuf:"bytes,1,opt,name=recording,proto3" json:"recording,omitempty"`
 }
 
-func (x *GetHostsResponse) Reset() {
-	*x = GetHostsResponse{}
+func (x *ConvertRecordingResponse) Reset() {
+	*x = ConvertRecordingResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_agent_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -318,13 +368,13 @@ func (x *GetHostsResponse) Reset() {
 	}
 }
 
-func (x *GetHostsResponse) String() string {
+func (x *ConvertRecordingResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetHostsResponse) ProtoMessage() {}
+func (*ConvertRecordingResponse) ProtoMessage() {}
 
-func (x *GetHostsResponse) ProtoReflect() protoreflect.Message {
+func (x *ConvertRecordingResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_agent_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -336,31 +386,29 @@ func (x *GetHostsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetHostsResponse.ProtoReflect.Descriptor instead.
-func (*GetHostsResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ConvertRecordingResponse.ProtoReflect.Descriptor instead.
+func (*ConvertRecordingResponse) Descriptor() ([]byte, []int) {
 	return file_agent_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *GetHostsResponse) GetHosts() []string {
+func (x *ConvertRecordingResponse) GetRecording() *Recording {
 	if x != nil {
-		return x.Hosts
+		return x.Recording
 	}
 	return nil
 }
 
-type MonitorResponse struct {
+type SpectatorRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Hb          string     `protobuf:"bytes,1,opt,name=hb,proto3" json:"hb,omitempty"`
-	NewLabs     []*Lab     `protobuf:"bytes,2,rep,name=newLabs,proto3" json:"newLabs,omitempty"`
-	Resources   *Resources `protobuf:"bytes,3,opt,name=resources,proto3" json:"resources,omitempty"`
-	QueuedTasks uint32     `protobuf:"varint,4,opt,name=queuedTasks,proto3" json:"queuedTasks,omitempty"`
+	LabTag        string `protobuf:"bytes,1,opt,name=labTag,proto3" json:"labTag,omitempty"`
+	ExpiryMinutes uint32 `protobuf:"varint,2,opt,name=expiryMinutes,proto3" json:"expiryMinutes,omitempty"`
 }
 
-func (x *MonitorResponse) Reset() {
-	*x = MonitorResponse{}
+func (x *SpectatorRequest) Reset() {
+	*x = SpectatorRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_agent_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -368,13 +416,13 @@ func (x *MonitorResponse) Reset() {
 	}
 }
 
-func (x *MonitorResponse) String() string {
+func (x *SpectatorRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MonitorResponse) ProtoMessage() {}
+func (*SpectatorRequest) ProtoMessage() {}
 
-func (x *MonitorResponse) ProtoReflect() protoreflect.Message {
+func (x *SpectatorRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_agent_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -386,55 +434,36 @@ func (x *MonitorResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MonitorResponse.ProtoReflect.Descriptor instead.
-func (*MonitorResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use SpectatorRequest.ProtoReflect.Descriptor instead.
+func (*SpectatorRequest) Descriptor() ([]byte, []int) {
 	return file_agent_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *MonitorResponse) GetHb() string {
+func (x *SpectatorRequest) GetLabTag() string {
 	if x != nil {
-		return x.Hb
+		return x.LabTag
 	}
 	return ""
 }
 
-func (x *MonitorResponse) GetNewLabs() []*Lab {
-	if x != nil {
-		return x.NewLabs
-	}
-	return nil
-}
-
-func (x *MonitorResponse) GetResources() *Resources {
-	if x != nil {
-		return x.Resources
-	}
-	return nil
-}
-
-func (x *MonitorResponse) GetQueuedTasks() uint32 {
+func (x *SpectatorRequest) GetExpiryMinutes() uint32 {
 	if x != nil {
-		return x.QueuedTasks
+		return x.ExpiryMinutes
 	}
 	return 0
 }
 
-type Resources struct {
+type SpectatorResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	MemAvailable   uint64  `protobuf:"varint,1,opt,name=memAvailable,proto3" json:"memAvailable,omitempty"`
-	Cpu            float64 `protobuf:"fixed64,2,opt,name=cpu,proto3" json:"cpu,omitempty"`
-	MemPercentUsed float64 `protobuf:"fixed64,3,opt,name=memPercentUsed,proto3" json:"memPercentUsed,omitempty"`
-	LabCount       uint32  `protobuf:"varint,4,opt,name=labCount,proto3" json:"labCount,omitempty"`
-	VmCount        uint32  `protobuf:"varint,5,opt,name=vmCount,proto3" json:"vmCount,omitempty"`
-	ContainerCount uint32  `protobuf:"varint,6,opt,name=containerCount,proto3" json:"containerCount,omitempty"`
-	MemInstalled   uint64  `protobuf:"varint,7,opt,name=memInstalled,proto3" json:"memInstalled,omitempty"`
+	LoginUrl  string `protobuf:"bytes,1,opt,name=loginUrl,proto3" json:"loginUrl,omitempty"`
+	ExpiresAt int64  `protobuf:"varint,2,opt,name=expiresAt,proto3" json:"expiresAt,omitempty"`
 }
 
-func (x *Resources) Reset() {
-	*x = Resources{}
+func (x *SpectatorResponse) Reset() {
+	*x = SpectatorResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_agent_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -442,13 +471,13 @@ func (x *Resources) Reset() {
 	}
 }
 
-func (x *Resources) String() string {
+func (x *SpectatorResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Resources) ProtoMessage() {}
+func (*SpectatorResponse) ProtoMessage() {}
 
-func (x *Resources) ProtoReflect() protoreflect.Message {
+func (x *SpectatorResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_agent_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -460,70 +489,36 @@ func (x *Resources) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Resources.ProtoReflect.Descriptor instead.
-func (*Resources) Descriptor() ([]byte, []int) {
+// Deprecated: Use SpectatorResponse.ProtoReflect.Descriptor instead.
+func (*SpectatorResponse) Descriptor() ([]byte, []int) {
 	return file_agent_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *Resources) GetMemAvailable() uint64 {
-	if x != nil {
-		return x.MemAvailable
-	}
-	return 0
-}
-
-func (x *Resources) GetCpu() float64 {
-	if x != nil {
-		return x.Cpu
-	}
-	return 0
-}
-
-func (x *Resources) GetMemPercentUsed() float64 {
-	if x != nil {
-		return x.MemPercentUsed
-	}
-	return 0
-}
-
-func (x *Resources) GetLabCount() uint32 {
-	if x != nil {
-		return x.LabCount
-	}
-	return 0
-}
-
-func (x *Resources) GetVmCount() uint32 {
-	if x != nil {
-		return x.VmCount
-	}
-	return 0
-}
-
-func (x *Resources) GetContainerCount() uint32 {
+func (x *SpectatorResponse) GetLoginUrl() string {
 	if x != nil {
-		return x.ContainerCount
+		return x.LoginUrl
 	}
-	return 0
+	return ""
 }
 
-func (x *Resources) GetMemInstalled() uint64 {
+func (x *SpectatorResponse) GetExpiresAt() int64 {
 	if x != nil {
-		return x.MemInstalled
+		return x.ExpiresAt
 	}
 	return 0
 }
 
-type PingRequest struct {
+type TerminalRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Ping string `protobuf:"bytes,1,opt,name=ping,proto3" json:"ping,omitempty"`
+	LabTag        string `protobuf:"bytes,1,opt,name=labTag,proto3" json:"labTag,omitempty"`
+	ExpiryMinutes uint32 `protobuf:"varint,2,opt,name=expiryMinutes,proto3" json:"expiryMinutes,omitempty"`
 }
 
-func (x *PingRequest) Reset() {
-	*x = PingRequest{}
+func (x *TerminalRequest) Reset() {
+	*x = TerminalRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_agent_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -531,13 +526,13 @@ func (x *PingRequest) Reset() {
 	}
 }
 
-func (x *PingRequest) String() string {
+func (x *TerminalRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PingRequest) ProtoMessage() {}
+func (*TerminalRequest) ProtoMessage() {}
 
-func (x *PingRequest) ProtoReflect() protoreflect.Message {
+func (x *TerminalRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_agent_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -549,29 +544,36 @@ func (x *PingRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PingRequest.ProtoReflect.Descriptor instead.
-func (*PingRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use TerminalRequest.ProtoReflect.Descriptor instead.
+func (*TerminalRequest) Descriptor() ([]byte, []int) {
 	return file_agent_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *PingRequest) GetPing() string {
+func (x *TerminalRequest) GetLabTag() string {
 	if x != nil {
-		return x.Ping
+		return x.LabTag
 	}
 	return ""
 }
 
-type PingResponse struct {
+func (x *TerminalRequest) GetExpiryMinutes() uint32 {
+	if x != nil {
+		return x.ExpiryMinutes
+	}
+	return 0
+}
+
+type TerminalResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Pong         string `protobuf:"bytes,1,opt,name=pong,proto3" json:"pong,omitempty"`
-	MemInstalled uint64 `protobuf:"varint,2,opt,name=memInstalled,proto3" json:"memInstalled,omitempty"`
+	TerminalUrl string `protobuf:"bytes,1,opt,name=terminalUrl,proto3" json:"terminalUrl,omitempty"`
+	ExpiresAt   int64  `protobuf:"varint,2,opt,name=expiresAt,proto3" json:"expiresAt,omitempty"`
 }
 
-func (x *PingResponse) Reset() {
-	*x = PingResponse{}
+func (x *TerminalResponse) Reset() {
+	*x = TerminalResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_agent_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -579,13 +581,13 @@ func (x *PingResponse) Reset() {
 	}
 }
 
-func (x *PingResponse) String() string {
+func (x *TerminalResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PingResponse) ProtoMessage() {}
+func (*TerminalResponse) ProtoMessage() {}
 
-func (x *PingResponse) ProtoReflect() protoreflect.Message {
+func (x *TerminalResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_agent_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -597,41 +599,38 @@ func (x *PingResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PingResponse.ProtoReflect.Descriptor instead.
-func (*PingResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use TerminalResponse.ProtoReflect.Descriptor instead.
+func (*TerminalResponse) Descriptor() ([]byte, []int) {
 	return file_agent_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *PingResponse) GetPong() string {
+func (x *TerminalResponse) GetTerminalUrl() string {
 	if x != nil {
-		return x.Pong
+		return x.TerminalUrl
 	}
 	return ""
 }
 
-func (x *PingResponse) GetMemInstalled() uint64 {
+func (x *TerminalResponse) GetExpiresAt() int64 {
 	if x != nil {
-		return x.MemInstalled
+		return x.ExpiresAt
 	}
 	return 0
 }
 
-type CreatEnvRequest struct {
+// Sets the level of the agent log. If resetAfter is set, the configured level
+// is restored after that many seconds, so debug logging is not left on
+type SetLogLevelRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	EventTag        string            `protobuf:"bytes,1,opt,name=eventTag,proto3" json:"eventTag,omitempty"`
-	EnvType         int32             `protobuf:"varint,2,opt,name=envType,proto3" json:"envType,omitempty"`
-	Vm              *VmConfig         `protobuf:"bytes,3,opt,name=vm,proto3" json:"vm,omitempty"`
-	InitialLabs     int32             `protobuf:"varint,4,opt,name=initialLabs,proto3" json:"initialLabs,omitempty"`
-	Exercises       []string          `protobuf:"bytes,5,rep,name=exercises,proto3" json:"exercises,omitempty"`
-	TeamSize        int32             `protobuf:"varint,6,opt,name=teamSize,proto3" json:"teamSize,omitempty"`
-	ExerciseConfigs []*ExerciseConfig `protobuf:"bytes,7,rep,name=exerciseConfigs,proto3" json:"exerciseConfigs,omitempty"`
+	Level      string `protobuf:"bytes,1,opt,name=level,proto3" json:"level,omitempty"`
+	ResetAfter int64  `protobuf:"varint,2,opt,name=resetAfter,proto3" json:"resetAfter,omitempty"`
 }
 
-func (x *CreatEnvRequest) Reset() {
-	*x = CreatEnvRequest{}
+func (x *SetLogLevelRequest) Reset() {
+	*x = SetLogLevelRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_agent_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -639,13 +638,13 @@ func (x *CreatEnvRequest) Reset() {
 	}
 }
 
-func (x *CreatEnvRequest) String() string {
+func (x *SetLogLevelRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreatEnvRequest) ProtoMessage() {}
+func (*SetLogLevelRequest) ProtoMessage() {}
 
-func (x *CreatEnvRequest) ProtoReflect() protoreflect.Message {
+func (x *SetLogLevelRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_agent_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -657,61 +656,81 @@ func (x *CreatEnvRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreatEnvRequest.ProtoReflect.Descriptor instead.
-func (*CreatEnvRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use SetLogLevelRequest.ProtoReflect.Descriptor instead.
+func (*SetLogLevelRequest) Descriptor() ([]byte, []int) {
 	return file_agent_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *CreatEnvRequest) GetEventTag() string {
+func (x *SetLogLevelRequest) GetLevel() string {
 	if x != nil {
-		return x.EventTag
+		return x.Level
 	}
 	return ""
 }
 
-func (x *CreatEnvRequest) GetEnvType() int32 {
+func (x *SetLogLevelRequest) GetResetAfter() int64 {
 	if x != nil {
-		return x.EnvType
+		return x.ResetAfter
 	}
 	return 0
 }
 
-func (x *CreatEnvRequest) GetVm() *VmConfig {
-	if x != nil {
-		return x.Vm
+type SetLogLevelResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PreviousLevel string `protobuf:"bytes,1,opt,name=previousLevel,proto3" json:"previousLevel,omitempty"`
+	Level         string `protobuf:"bytes,2,opt,name=level,proto3" json:"level,omitempty"`
+}
+
+func (x *SetLogLevelResponse) Reset() {
+	*x = SetLogLevelResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_agent_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return nil
 }
 
-func (x *CreatEnvRequest) GetInitialLabs() int32 {
-	if x != nil {
-		return x.InitialLabs
+func (x *SetLogLevelResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetLogLevelResponse) ProtoMessage() {}
+
+func (x *SetLogLevelResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_agent_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *CreatEnvRequest) GetExercises() []string {
+// Deprecated: Use SetLogLevelResponse.ProtoReflect.Descriptor instead.
+func (*SetLogLevelResponse) Descriptor() ([]byte, []int) {
+	return file_agent_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *SetLogLevelResponse) GetPreviousLevel() string {
 	if x != nil {
-		return x.Exercises
+		return x.PreviousLevel
 	}
-	return nil
+	return ""
 }
 
-func (x *CreatEnvRequest) GetTeamSize() int32 {
+func (x *SetLogLevelResponse) GetLevel() string {
 	if x != nil {
-		return x.TeamSize
-	}
-	return 0
-}
-
-func (x *CreatEnvRequest) GetExerciseConfigs() []*ExerciseConfig {
-	if x != nil {
-		return x.ExerciseConfigs
+		return x.Level
 	}
-	return nil
+	return ""
 }
 
-type CloseEnvRequest struct {
+type ListTasksRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
@@ -719,23 +738,23 @@ type CloseEnvRequest struct {
 	EventTag string `protobuf:"bytes,1,opt,name=eventTag,proto3" json:"eventTag,omitempty"`
 }
 
-func (x *CloseEnvRequest) Reset() {
-	*x = CloseEnvRequest{}
+func (x *ListTasksRequest) Reset() {
+	*x = ListTasksRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_agent_proto_msgTypes[12]
+		mi := &file_agent_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *CloseEnvRequest) String() string {
+func (x *ListTasksRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CloseEnvRequest) ProtoMessage() {}
+func (*ListTasksRequest) ProtoMessage() {}
 
-func (x *CloseEnvRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_agent_proto_msgTypes[12]
+func (x *ListTasksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_agent_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -746,45 +765,43 @@ func (x *CloseEnvRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CloseEnvRequest.ProtoReflect.Descriptor instead.
-func (*CloseEnvRequest) Descriptor() ([]byte, []int) {
-	return file_agent_proto_rawDescGZIP(), []int{12}
+// Deprecated: Use ListTasksRequest.ProtoReflect.Descriptor instead.
+func (*ListTasksRequest) Descriptor() ([]byte, []int) {
+	return file_agent_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *CloseEnvRequest) GetEventTag() string {
+func (x *ListTasksRequest) GetEventTag() string {
 	if x != nil {
 		return x.EventTag
 	}
 	return ""
 }
 
-type ListEnvResponse struct {
+type ListTasksResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	EventTags         map[string]bool `protobuf:"bytes,1,rep,name=eventTags,proto3" json:"eventTags,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
-	StartingEventTags map[string]bool `protobuf:"bytes,2,rep,name=startingEventTags,proto3" json:"startingEventTags,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
-	ClosingEventTags  map[string]bool `protobuf:"bytes,3,rep,name=closingEventTags,proto3" json:"closingEventTags,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	Tasks []*Task `protobuf:"bytes,1,rep,name=tasks,proto3" json:"tasks,omitempty"`
 }
 
-func (x *ListEnvResponse) Reset() {
-	*x = ListEnvResponse{}
+func (x *ListTasksResponse) Reset() {
+	*x = ListTasksResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_agent_proto_msgTypes[13]
+		mi := &file_agent_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ListEnvResponse) String() string {
+func (x *ListTasksResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListEnvResponse) ProtoMessage() {}
+func (*ListTasksResponse) ProtoMessage() {}
 
-func (x *ListEnvResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_agent_proto_msgTypes[13]
+func (x *ListTasksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_agent_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -795,58 +812,53 @@ func (x *ListEnvResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListEnvResponse.ProtoReflect.Descriptor instead.
-func (*ListEnvResponse) Descriptor() ([]byte, []int) {
-	return file_agent_proto_rawDescGZIP(), []int{13}
-}
-
-func (x *ListEnvResponse) GetEventTags() map[string]bool {
-	if x != nil {
-		return x.EventTags
-	}
-	return nil
-}
-
-func (x *ListEnvResponse) GetStartingEventTags() map[string]bool {
-	if x != nil {
-		return x.StartingEventTags
-	}
-	return nil
+// Deprecated: Use ListTasksResponse.ProtoReflect.Descriptor instead.
+func (*ListTasksResponse) Descriptor() ([]byte, []int) {
+	return file_agent_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *ListEnvResponse) GetClosingEventTags() map[string]bool {
+func (x *ListTasksResponse) GetTasks() []*Task {
 	if x != nil {
-		return x.ClosingEventTags
+		return x.Tasks
 	}
 	return nil
 }
 
-type CreateLabRequest struct {
+type Task struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	EventTag string `protobuf:"bytes,1,opt,name=eventTag,proto3" json:"eventTag,omitempty"`
-	IsVPN    bool   `protobuf:"varint,2,opt,name=isVPN,proto3" json:"isVPN,omitempty"`
-}
-
-func (x *CreateLabRequest) Reset() {
-	*x = CreateLabRequest{}
+	Id         string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name       string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	EventTag   string `protobuf:"bytes,3,opt,name=eventTag,proto3" json:"eventTag,omitempty"`
+	LabTag     string `protobuf:"bytes,4,opt,name=labTag,proto3" json:"labTag,omitempty"`
+	Priority   string `protobuf:"bytes,5,opt,name=priority,proto3" json:"priority,omitempty"`
+	State      string `protobuf:"bytes,6,opt,name=state,proto3" json:"state,omitempty"`
+	Error      string `protobuf:"bytes,7,opt,name=error,proto3" json:"error,omitempty"`
+	CreatedAt  int64  `protobuf:"varint,8,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	StartedAt  int64  `protobuf:"varint,9,opt,name=startedAt,proto3" json:"startedAt,omitempty"`
+	FinishedAt int64  `protobuf:"varint,10,opt,name=finishedAt,proto3" json:"finishedAt,omitempty"`
+	Attempts   int32  `protobuf:"varint,11,opt,name=attempts,proto3" json:"attempts,omitempty"`
+}
+
+func (x *Task) Reset() {
+	*x = Task{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_agent_proto_msgTypes[14]
+		mi := &file_agent_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *CreateLabRequest) String() string {
+func (x *Task) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateLabRequest) ProtoMessage() {}
+func (*Task) ProtoMessage() {}
 
-func (x *CreateLabRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_agent_proto_msgTypes[14]
+func (x *Task) ProtoReflect() protoreflect.Message {
+	mi := &file_agent_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -857,82 +869,108 @@ func (x *CreateLabRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateLabRequest.ProtoReflect.Descriptor instead.
-func (*CreateLabRequest) Descriptor() ([]byte, []int) {
-	return file_agent_proto_rawDescGZIP(), []int{14}
+// Deprecated: Use Task.ProtoReflect.Descriptor instead.
+func (*Task) Descriptor() ([]byte, []int) {
+	return file_agent_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *CreateLabRequest) GetEventTag() string {
+func (x *Task) GetId() string {
 	if x != nil {
-		return x.EventTag
+		return x.Id
 	}
 	return ""
 }
 
-func (x *CreateLabRequest) GetIsVPN() bool {
+func (x *Task) GetName() string {
 	if x != nil {
-		return x.IsVPN
+		return x.Name
 	}
-	return false
+	return ""
 }
 
-type CreateVpnConfRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x *Task) GetEventTag() string {
+	if x != nil {
+		return x.EventTag
+	}
+	return ""
+}
 
-	LabTag string `protobuf:"bytes,1,opt,name=labTag,proto3" json:"labTag,omitempty"`
+func (x *Task) GetLabTag() string {
+	if x != nil {
+		return x.LabTag
+	}
+	return ""
 }
 
-func (x *CreateVpnConfRequest) Reset() {
-	*x = CreateVpnConfRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_agent_proto_msgTypes[15]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *Task) GetPriority() string {
+	if x != nil {
+		return x.Priority
 	}
+	return ""
 }
 
-func (x *CreateVpnConfRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *Task) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
 }
 
-func (*CreateVpnConfRequest) ProtoMessage() {}
+func (x *Task) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
 
-func (x *CreateVpnConfRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_agent_proto_msgTypes[15]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *Task) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
 	}
-	return mi.MessageOf(x)
+	return 0
 }
 
-// Deprecated: Use CreateVpnConfRequest.ProtoReflect.Descriptor instead.
-func (*CreateVpnConfRequest) Descriptor() ([]byte, []int) {
-	return file_agent_proto_rawDescGZIP(), []int{15}
+func (x *Task) GetStartedAt() int64 {
+	if x != nil {
+		return x.StartedAt
+	}
+	return 0
 }
 
-func (x *CreateVpnConfRequest) GetLabTag() string {
+func (x *Task) GetFinishedAt() int64 {
 	if x != nil {
-		return x.LabTag
+		return x.FinishedAt
 	}
-	return ""
+	return 0
 }
 
-type CreateVpnConfResponse struct {
+func (x *Task) GetAttempts() int32 {
+	if x != nil {
+		return x.Attempts
+	}
+	return 0
+}
+
+type AgentInfoResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Configs []string `protobuf:"bytes,1,rep,name=configs,proto3" json:"configs,omitempty"`
-}
-
-func (x *CreateVpnConfResponse) Reset() {
-	*x = CreateVpnConfResponse{}
+	Version          string          `protobuf:"bytes,1,opt,name=version,proto3" json:"version,omitempty"`
+	CompileDate      string          `protobuf:"bytes,2,opt,name=compileDate,proto3" json:"compileDate,omitempty"`
+	GoVersion        string          `protobuf:"bytes,3,opt,name=goVersion,proto3" json:"goVersion,omitempty"`
+	Os               string          `protobuf:"bytes,4,opt,name=os,proto3" json:"os,omitempty"`
+	Arch             string          `protobuf:"bytes,5,opt,name=arch,proto3" json:"arch,omitempty"`
+	LabTypes         []string        `protobuf:"bytes,6,rep,name=labTypes,proto3" json:"labTypes,omitempty"`
+	FrontendBackends []string        `protobuf:"bytes,7,rep,name=frontendBackends,proto3" json:"frontendBackends,omitempty"`
+	VpnBackends      []string        `protobuf:"bytes,8,rep,name=vpnBackends,proto3" json:"vpnBackends,omitempty"`
+	Ovas             []string        `protobuf:"bytes,9,rep,name=ovas,proto3" json:"ovas,omitempty"`
+	Registries       []string        `protobuf:"bytes,10,rep,name=registries,proto3" json:"registries,omitempty"`
+	Features         map[string]bool `protobuf:"bytes,11,rep,name=features,proto3" json:"features,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+}
+
+func (x *AgentInfoResponse) Reset() {
+	*x = AgentInfoResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_agent_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -940,13 +978,13 @@ func (x *CreateVpnConfResponse) Reset() {
 	}
 }
 
-func (x *CreateVpnConfResponse) String() string {
+func (x *AgentInfoResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateVpnConfResponse) ProtoMessage() {}
+func (*AgentInfoResponse) ProtoMessage() {}
 
-func (x *CreateVpnConfResponse) ProtoReflect() protoreflect.Message {
+func (x *AgentInfoResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_agent_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -958,28 +996,101 @@ func (x *CreateVpnConfResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateVpnConfResponse.ProtoReflect.Descriptor instead.
-func (*CreateVpnConfResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use AgentInfoResponse.ProtoReflect.Descriptor instead.
+func (*AgentInfoResponse) Descriptor() ([]byte, []int) {
 	return file_agent_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *CreateVpnConfResponse) GetConfigs() []string {
+func (x *AgentInfoResponse) GetVersion() string {
 	if x != nil {
-		return x.Configs
+		return x.Version
+	}
+	return ""
+}
+
+func (x *AgentInfoResponse) GetCompileDate() string {
+	if x != nil {
+		return x.CompileDate
+	}
+	return ""
+}
+
+func (x *AgentInfoResponse) GetGoVersion() string {
+	if x != nil {
+		return x.GoVersion
+	}
+	return ""
+}
+
+func (x *AgentInfoResponse) GetOs() string {
+	if x != nil {
+		return x.Os
+	}
+	return ""
+}
+
+func (x *AgentInfoResponse) GetArch() string {
+	if x != nil {
+		return x.Arch
+	}
+	return ""
+}
+
+func (x *AgentInfoResponse) GetLabTypes() []string {
+	if x != nil {
+		return x.LabTypes
 	}
 	return nil
 }
 
-type CloseLabRequest struct {
+func (x *AgentInfoResponse) GetFrontendBackends() []string {
+	if x != nil {
+		return x.FrontendBackends
+	}
+	return nil
+}
+
+func (x *AgentInfoResponse) GetVpnBackends() []string {
+	if x != nil {
+		return x.VpnBackends
+	}
+	return nil
+}
+
+func (x *AgentInfoResponse) GetOvas() []string {
+	if x != nil {
+		return x.Ovas
+	}
+	return nil
+}
+
+func (x *AgentInfoResponse) GetRegistries() []string {
+	if x != nil {
+		return x.Registries
+	}
+	return nil
+}
+
+func (x *AgentInfoResponse) GetFeatures() map[string]bool {
+	if x != nil {
+		return x.Features
+	}
+	return nil
+}
+
+type AuditLogRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	LabTag string `protobuf:"bytes,1,opt,name=labTag,proto3" json:"labTag,omitempty"`
+	From  int64  `protobuf:"varint,1,opt,name=from,proto3" json:"from,omitempty"`
+	To    int64  `protobuf:"varint,2,opt,name=to,proto3" json:"to,omitempty"`
+	Tag   string `protobuf:"bytes,3,opt,name=tag,proto3" json:"tag,omitempty"`
+	Limit uint32 `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
 }
 
-func (x *CloseLabRequest) Reset() {
-	*x = CloseLabRequest{}
+func (x *AuditLogRequest) Reset() {
+	*x = AuditLogRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_agent_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -987,13 +1098,13 @@ func (x *CloseLabRequest) Reset() {
 	}
 }
 
-func (x *CloseLabRequest) String() string {
+func (x *AuditLogRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CloseLabRequest) ProtoMessage() {}
+func (*AuditLogRequest) ProtoMessage() {}
 
-func (x *CloseLabRequest) ProtoReflect() protoreflect.Message {
+func (x *AuditLogRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_agent_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1005,32 +1116,57 @@ func (x *CloseLabRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CloseLabRequest.ProtoReflect.Descriptor instead.
-func (*CloseLabRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use AuditLogRequest.ProtoReflect.Descriptor instead.
+func (*AuditLogRequest) Descriptor() ([]byte, []int) {
 	return file_agent_proto_rawDescGZIP(), []int{17}
 }
 
-func (x *CloseLabRequest) GetLabTag() string {
+func (x *AuditLogRequest) GetFrom() int64 {
 	if x != nil {
-		return x.LabTag
+		return x.From
+	}
+	return 0
+}
+
+func (x *AuditLogRequest) GetTo() int64 {
+	if x != nil {
+		return x.To
+	}
+	return 0
+}
+
+func (x *AuditLogRequest) GetTag() string {
+	if x != nil {
+		return x.Tag
 	}
 	return ""
 }
 
-type ExerciseRequest struct {
+func (x *AuditLogRequest) GetLimit() uint32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type AuditEntry struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	LabTag          string            `protobuf:"bytes,1,opt,name=labTag,proto3" json:"labTag,omitempty"`
-	EnvTag          string            `protobuf:"bytes,2,opt,name=envTag,proto3" json:"envTag,omitempty"`
-	Exercises       []string          `protobuf:"bytes,3,rep,name=exercises,proto3" json:"exercises,omitempty"`
-	Exercise        string            `protobuf:"bytes,4,opt,name=exercise,proto3" json:"exercise,omitempty"`
-	ExerciseConfigs []*ExerciseConfig `protobuf:"bytes,5,rep,name=exerciseConfigs,proto3" json:"exerciseConfigs,omitempty"`
+	Time       int64  `protobuf:"varint,1,opt,name=time,proto3" json:"time,omitempty"`
+	Caller     string `protobuf:"bytes,2,opt,name=caller,proto3" json:"caller,omitempty"`
+	Peer       string `protobuf:"bytes,3,opt,name=peer,proto3" json:"peer,omitempty"`
+	Method     string `protobuf:"bytes,4,opt,name=method,proto3" json:"method,omitempty"`
+	EventTag   string `protobuf:"bytes,5,opt,name=eventTag,proto3" json:"eventTag,omitempty"`
+	LabTag     string `protobuf:"bytes,6,opt,name=labTag,proto3" json:"labTag,omitempty"`
+	Result     string `protobuf:"bytes,7,opt,name=result,proto3" json:"result,omitempty"`
+	Error      string `protobuf:"bytes,8,opt,name=error,proto3" json:"error,omitempty"`
+	DurationMs int64  `protobuf:"varint,9,opt,name=durationMs,proto3" json:"durationMs,omitempty"`
 }
 
-func (x *ExerciseRequest) Reset() {
-	*x = ExerciseRequest{}
+func (x *AuditEntry) Reset() {
+	*x = AuditEntry{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_agent_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1038,13 +1174,13 @@ func (x *ExerciseRequest) Reset() {
 	}
 }
 
-func (x *ExerciseRequest) String() string {
+func (x *AuditEntry) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ExerciseRequest) ProtoMessage() {}
+func (*AuditEntry) ProtoMessage() {}
 
-func (x *ExerciseRequest) ProtoReflect() protoreflect.Message {
+func (x *AuditEntry) ProtoReflect() protoreflect.Message {
 	mi := &file_agent_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1056,58 +1192,84 @@ func (x *ExerciseRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ExerciseRequest.ProtoReflect.Descriptor instead.
-func (*ExerciseRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use AuditEntry.ProtoReflect.Descriptor instead.
+func (*AuditEntry) Descriptor() ([]byte, []int) {
 	return file_agent_proto_rawDescGZIP(), []int{18}
 }
 
-func (x *ExerciseRequest) GetLabTag() string {
+func (x *AuditEntry) GetTime() int64 {
 	if x != nil {
-		return x.LabTag
+		return x.Time
 	}
-	return ""
+	return 0
 }
 
-func (x *ExerciseRequest) GetEnvTag() string {
+func (x *AuditEntry) GetCaller() string {
 	if x != nil {
-		return x.EnvTag
+		return x.Caller
 	}
 	return ""
 }
 
-func (x *ExerciseRequest) GetExercises() []string {
+func (x *AuditEntry) GetPeer() string {
 	if x != nil {
-		return x.Exercises
+		return x.Peer
 	}
-	return nil
+	return ""
 }
 
-func (x *ExerciseRequest) GetExercise() string {
+func (x *AuditEntry) GetMethod() string {
 	if x != nil {
-		return x.Exercise
+		return x.Method
 	}
 	return ""
 }
 
-func (x *ExerciseRequest) GetExerciseConfigs() []*ExerciseConfig {
+func (x *AuditEntry) GetEventTag() string {
 	if x != nil {
-		return x.ExerciseConfigs
+		return x.EventTag
 	}
-	return nil
+	return ""
 }
 
-type VmConfig struct {
+func (x *AuditEntry) GetLabTag() string {
+	if x != nil {
+		return x.LabTag
+	}
+	return ""
+}
+
+func (x *AuditEntry) GetResult() string {
+	if x != nil {
+		return x.Result
+	}
+	return ""
+}
+
+func (x *AuditEntry) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *AuditEntry) GetDurationMs() int64 {
+	if x != nil {
+		return x.DurationMs
+	}
+	return 0
+}
+
+type AuditLogResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Image    string  `protobuf:"bytes,1,opt,name=image,proto3" json:"image,omitempty"`
-	MemoryMB uint32  `protobuf:"varint,2,opt,name=memoryMB,proto3" json:"memoryMB,omitempty"`
-	Cpu      float64 `protobuf:"fixed64,3,opt,name=cpu,proto3" json:"cpu,omitempty"`
+	Entries []*AuditEntry `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
 }
 
-func (x *VmConfig) Reset() {
-	*x = VmConfig{}
+func (x *AuditLogResponse) Reset() {
+	*x = AuditLogResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_agent_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1115,13 +1277,13 @@ func (x *VmConfig) Reset() {
 	}
 }
 
-func (x *VmConfig) String() string {
+func (x *AuditLogResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*VmConfig) ProtoMessage() {}
+func (*AuditLogResponse) ProtoMessage() {}
 
-func (x *VmConfig) ProtoReflect() protoreflect.Message {
+func (x *AuditLogResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_agent_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1133,42 +1295,28 @@ func (x *VmConfig) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use VmConfig.ProtoReflect.Descriptor instead.
-func (*VmConfig) Descriptor() ([]byte, []int) {
+// Deprecated: Use AuditLogResponse.ProtoReflect.Descriptor instead.
+func (*AuditLogResponse) Descriptor() ([]byte, []int) {
 	return file_agent_proto_rawDescGZIP(), []int{19}
 }
 
-func (x *VmConfig) GetImage() string {
-	if x != nil {
-		return x.Image
-	}
-	return ""
-}
-
-func (x *VmConfig) GetMemoryMB() uint32 {
-	if x != nil {
-		return x.MemoryMB
-	}
-	return 0
-}
-
-func (x *VmConfig) GetCpu() float64 {
+func (x *AuditLogResponse) GetEntries() []*AuditEntry {
 	if x != nil {
-		return x.Cpu
+		return x.Entries
 	}
-	return 0
+	return nil
 }
 
-type StatusResponse struct {
+type ResetLabRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Message string `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	LabTag string `protobuf:"bytes,1,opt,name=labTag,proto3" json:"labTag,omitempty"`
 }
 
-func (x *StatusResponse) Reset() {
-	*x = StatusResponse{}
+func (x *ResetLabRequest) Reset() {
+	*x = ResetLabRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_agent_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1176,13 +1324,13 @@ func (x *StatusResponse) Reset() {
 	}
 }
 
-func (x *StatusResponse) String() string {
+func (x *ResetLabRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StatusResponse) ProtoMessage() {}
+func (*ResetLabRequest) ProtoMessage() {}
 
-func (x *StatusResponse) ProtoReflect() protoreflect.Message {
+func (x *ResetLabRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_agent_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1194,33 +1342,28 @@ func (x *StatusResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StatusResponse.ProtoReflect.Descriptor instead.
-func (*StatusResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ResetLabRequest.ProtoReflect.Descriptor instead.
+func (*ResetLabRequest) Descriptor() ([]byte, []int) {
 	return file_agent_proto_rawDescGZIP(), []int{20}
 }
 
-func (x *StatusResponse) GetMessage() string {
+func (x *ResetLabRequest) GetLabTag() string {
 	if x != nil {
-		return x.Message
+		return x.LabTag
 	}
 	return ""
 }
 
-type Lab struct {
+type GetLabRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Tag       string      `protobuf:"bytes,1,opt,name=tag,proto3" json:"tag,omitempty"`
-	EventTag  string      `protobuf:"bytes,2,opt,name=eventTag,proto3" json:"eventTag,omitempty"`
-	Exercises []*Exercise `protobuf:"bytes,3,rep,name=exercises,proto3" json:"exercises,omitempty"`
-	IsVPN     bool        `protobuf:"varint,4,opt,name=isVPN,proto3" json:"isVPN,omitempty"`
-	GuacCreds *GuacCreds  `protobuf:"bytes,5,opt,name=guacCreds,proto3" json:"guacCreds,omitempty"`
-	VpnConfs  []string    `protobuf:"bytes,6,rep,name=vpnConfs,proto3" json:"vpnConfs,omitempty"`
+	LabTag string `protobuf:"bytes,1,opt,name=labTag,proto3" json:"labTag,omitempty"`
 }
 
-func (x *Lab) Reset() {
-	*x = Lab{}
+func (x *GetLabRequest) Reset() {
+	*x = GetLabRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_agent_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1228,13 +1371,13 @@ func (x *Lab) Reset() {
 	}
 }
 
-func (x *Lab) String() string {
+func (x *GetLabRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Lab) ProtoMessage() {}
+func (*GetLabRequest) ProtoMessage() {}
 
-func (x *Lab) ProtoReflect() protoreflect.Message {
+func (x *GetLabRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_agent_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1246,65 +1389,28 @@ func (x *Lab) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Lab.ProtoReflect.Descriptor instead.
-func (*Lab) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetLabRequest.ProtoReflect.Descriptor instead.
+func (*GetLabRequest) Descriptor() ([]byte, []int) {
 	return file_agent_proto_rawDescGZIP(), []int{21}
 }
 
-func (x *Lab) GetTag() string {
-	if x != nil {
-		return x.Tag
-	}
-	return ""
-}
-
-func (x *Lab) GetEventTag() string {
+func (x *GetLabRequest) GetLabTag() string {
 	if x != nil {
-		return x.EventTag
+		return x.LabTag
 	}
 	return ""
 }
 
-func (x *Lab) GetExercises() []*Exercise {
-	if x != nil {
-		return x.Exercises
-	}
-	return nil
-}
-
-func (x *Lab) GetIsVPN() bool {
-	if x != nil {
-		return x.IsVPN
-	}
-	return false
-}
-
-func (x *Lab) GetGuacCreds() *GuacCreds {
-	if x != nil {
-		return x.GuacCreds
-	}
-	return nil
-}
-
-func (x *Lab) GetVpnConfs() []string {
-	if x != nil {
-		return x.VpnConfs
-	}
-	return nil
-}
-
-type Exercise struct {
+type GetLabResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Tag            string           `protobuf:"bytes,1,opt,name=tag,proto3" json:"tag,omitempty"`
-	ChildExercises []*ChildExercise `protobuf:"bytes,2,rep,name=childExercises,proto3" json:"childExercises,omitempty"`
-	Machines       []*Machine       `protobuf:"bytes,3,rep,name=machines,proto3" json:"machines,omitempty"`
+	Lab *Lab `protobuf:"bytes,1,opt,name=lab,proto3" json:"lab,omitempty"`
 }
 
-func (x *Exercise) Reset() {
-	*x = Exercise{}
+func (x *GetLabResponse) Reset() {
+	*x = GetLabResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_agent_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1312,13 +1418,13 @@ func (x *Exercise) Reset() {
 	}
 }
 
-func (x *Exercise) String() string {
+func (x *GetLabResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Exercise) ProtoMessage() {}
+func (*GetLabResponse) ProtoMessage() {}
 
-func (x *Exercise) ProtoReflect() protoreflect.Message {
+func (x *GetLabResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_agent_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1330,43 +1436,28 @@ func (x *Exercise) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Exercise.ProtoReflect.Descriptor instead.
-func (*Exercise) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetLabResponse.ProtoReflect.Descriptor instead.
+func (*GetLabResponse) Descriptor() ([]byte, []int) {
 	return file_agent_proto_rawDescGZIP(), []int{22}
 }
 
-func (x *Exercise) GetTag() string {
-	if x != nil {
-		return x.Tag
-	}
-	return ""
-}
-
-func (x *Exercise) GetChildExercises() []*ChildExercise {
-	if x != nil {
-		return x.ChildExercises
-	}
-	return nil
-}
-
-func (x *Exercise) GetMachines() []*Machine {
+func (x *GetLabResponse) GetLab() *Lab {
 	if x != nil {
-		return x.Machines
+		return x.Lab
 	}
 	return nil
 }
 
-type ChildExercise struct {
+type GetHostsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Tag  string `protobuf:"bytes,1,opt,name=tag,proto3" json:"tag,omitempty"`
-	Flag string `protobuf:"bytes,2,opt,name=flag,proto3" json:"flag,omitempty"`
+	LabTag string `protobuf:"bytes,1,opt,name=labTag,proto3" json:"labTag,omitempty"`
 }
 
-func (x *ChildExercise) Reset() {
-	*x = ChildExercise{}
+func (x *GetHostsRequest) Reset() {
+	*x = GetHostsRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_agent_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1374,13 +1465,13 @@ func (x *ChildExercise) Reset() {
 	}
 }
 
-func (x *ChildExercise) String() string {
+func (x *GetHostsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ChildExercise) ProtoMessage() {}
+func (*GetHostsRequest) ProtoMessage() {}
 
-func (x *ChildExercise) ProtoReflect() protoreflect.Message {
+func (x *GetHostsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_agent_proto_msgTypes[23]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1392,39 +1483,28 @@ func (x *ChildExercise) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ChildExercise.ProtoReflect.Descriptor instead.
-func (*ChildExercise) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetHostsRequest.ProtoReflect.Descriptor instead.
+func (*GetHostsRequest) Descriptor() ([]byte, []int) {
 	return file_agent_proto_rawDescGZIP(), []int{23}
 }
 
-func (x *ChildExercise) GetTag() string {
-	if x != nil {
-		return x.Tag
-	}
-	return ""
-}
-
-func (x *ChildExercise) GetFlag() string {
+func (x *GetHostsRequest) GetLabTag() string {
 	if x != nil {
-		return x.Flag
+		return x.LabTag
 	}
 	return ""
 }
 
-type Machine struct {
+type GetHostsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id     string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Status string   `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
-	Image  string   `protobuf:"bytes,3,opt,name=image,proto3" json:"image,omitempty"`
-	Errors []string `protobuf:"bytes,4,rep,name=errors,proto3" json:"errors,omitempty"`
-	Type   string   `protobuf:"bytes,5,opt,name=type,proto3" json:"type,omitempty"`
+	Hosts []string `protobuf:"bytes,1,rep,name=hosts,proto3" json:"hosts,omitempty"`
 }
 
-func (x *Machine) Reset() {
-	*x = Machine{}
+func (x *GetHostsResponse) Reset() {
+	*x = GetHostsResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_agent_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1432,13 +1512,13 @@ func (x *Machine) Reset() {
 	}
 }
 
-func (x *Machine) String() string {
+func (x *GetHostsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Machine) ProtoMessage() {}
+func (*GetHostsResponse) ProtoMessage() {}
 
-func (x *Machine) ProtoReflect() protoreflect.Message {
+func (x *GetHostsResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_agent_proto_msgTypes[24]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1450,71 +1530,50 @@ func (x *Machine) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Machine.ProtoReflect.Descriptor instead.
-func (*Machine) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetHostsResponse.ProtoReflect.Descriptor instead.
+func (*GetHostsResponse) Descriptor() ([]byte, []int) {
 	return file_agent_proto_rawDescGZIP(), []int{24}
 }
 
-func (x *Machine) GetId() string {
+func (x *GetHostsResponse) GetHosts() []string {
 	if x != nil {
-		return x.Id
+		return x.Hosts
 	}
-	return ""
+	return nil
 }
 
-func (x *Machine) GetStatus() string {
-	if x != nil {
-		return x.Status
-	}
-	return ""
-}
+type MonitorResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-func (x *Machine) GetImage() string {
-	if x != nil {
-		return x.Image
-	}
-	return ""
+	Hb                 string             `protobuf:"bytes,1,opt,name=hb,proto3" json:"hb,omitempty"`
+	NewLabs            []*Lab             `protobuf:"bytes,2,rep,name=newLabs,proto3" json:"newLabs,omitempty"`
+	Resources          *Resources         `protobuf:"bytes,3,opt,name=resources,proto3" json:"resources,omitempty"`
+	QueuedTasks        uint32             `protobuf:"varint,4,opt,name=queuedTasks,proto3" json:"queuedTasks,omitempty"`
+	RejectedRequests   uint64             `protobuf:"varint,5,opt,name=rejectedRequests,proto3" json:"rejectedRequests,omitempty"`
+	QueuedTasksByEvent map[string]uint32  `protobuf:"bytes,6,rep,name=queuedTasksByEvent,proto3" json:"queuedTasksByEvent,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	LabsByEvent        map[string]uint32  `protobuf:"bytes,7,rep,name=labsByEvent,proto3" json:"labsByEvent,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	LabUsage           []*LabUsage        `protobuf:"bytes,8,rep,name=labUsage,proto3" json:"labUsage,omitempty"`
+	Warnings           []*ResourceWarning `protobuf:"bytes,9,rep,name=warnings,proto3" json:"warnings,omitempty"`
 }
 
-func (x *Machine) GetErrors() []string {
-	if x != nil {
-		return x.Errors
+func (x *MonitorResponse) Reset() {
+	*x = MonitorResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_agent_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return nil
 }
 
-func (x *Machine) GetType() string {
-	if x != nil {
-		return x.Type
-	}
-	return ""
-}
-
-type GuacCreds struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Username string `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
-	Password string `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
-}
-
-func (x *GuacCreds) Reset() {
-	*x = GuacCreds{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_agent_proto_msgTypes[25]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
-}
-
-func (x *GuacCreds) String() string {
+func (x *MonitorResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GuacCreds) ProtoMessage() {}
+func (*MonitorResponse) ProtoMessage() {}
 
-func (x *GuacCreds) ProtoReflect() protoreflect.Message {
+func (x *MonitorResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_agent_proto_msgTypes[25]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1526,37 +1585,97 @@ func (x *GuacCreds) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GuacCreds.ProtoReflect.Descriptor instead.
-func (*GuacCreds) Descriptor() ([]byte, []int) {
+// Deprecated: Use MonitorResponse.ProtoReflect.Descriptor instead.
+func (*MonitorResponse) Descriptor() ([]byte, []int) {
 	return file_agent_proto_rawDescGZIP(), []int{25}
 }
 
-func (x *GuacCreds) GetUsername() string {
+func (x *MonitorResponse) GetHb() string {
 	if x != nil {
-		return x.Username
+		return x.Hb
 	}
 	return ""
 }
 
-func (x *GuacCreds) GetPassword() string {
+func (x *MonitorResponse) GetNewLabs() []*Lab {
 	if x != nil {
-		return x.Password
+		return x.NewLabs
 	}
-	return ""
+	return nil
 }
 
-type ExerciseConfig struct {
+func (x *MonitorResponse) GetResources() *Resources {
+	if x != nil {
+		return x.Resources
+	}
+	return nil
+}
+
+func (x *MonitorResponse) GetQueuedTasks() uint32 {
+	if x != nil {
+		return x.QueuedTasks
+	}
+	return 0
+}
+
+func (x *MonitorResponse) GetRejectedRequests() uint64 {
+	if x != nil {
+		return x.RejectedRequests
+	}
+	return 0
+}
+
+func (x *MonitorResponse) GetQueuedTasksByEvent() map[string]uint32 {
+	if x != nil {
+		return x.QueuedTasksByEvent
+	}
+	return nil
+}
+
+func (x *MonitorResponse) GetLabsByEvent() map[string]uint32 {
+	if x != nil {
+		return x.LabsByEvent
+	}
+	return nil
+}
+
+func (x *MonitorResponse) GetLabUsage() []*LabUsage {
+	if x != nil {
+		return x.LabUsage
+	}
+	return nil
+}
+
+func (x *MonitorResponse) GetWarnings() []*ResourceWarning {
+	if x != nil {
+		return x.Warnings
+	}
+	return nil
+}
+
+type Resources struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Tag      string                    `protobuf:"bytes,1,opt,name=tag,proto3" json:"tag,omitempty"`
-	Static   bool                      `protobuf:"varint,2,opt,name=static,proto3" json:"static,omitempty"`
-	Instance []*ExerciseInstanceConfig `protobuf:"bytes,3,rep,name=instance,proto3" json:"instance,omitempty"`
+	MemAvailable     uint64       `protobuf:"varint,1,opt,name=memAvailable,proto3" json:"memAvailable,omitempty"`
+	Cpu              float64      `protobuf:"fixed64,2,opt,name=cpu,proto3" json:"cpu,omitempty"`
+	MemPercentUsed   float64      `protobuf:"fixed64,3,opt,name=memPercentUsed,proto3" json:"memPercentUsed,omitempty"`
+	LabCount         uint32       `protobuf:"varint,4,opt,name=labCount,proto3" json:"labCount,omitempty"`
+	VmCount          uint32       `protobuf:"varint,5,opt,name=vmCount,proto3" json:"vmCount,omitempty"`
+	ContainerCount   uint32       `protobuf:"varint,6,opt,name=containerCount,proto3" json:"containerCount,omitempty"`
+	MemInstalled     uint64       `protobuf:"varint,7,opt,name=memInstalled,proto3" json:"memInstalled,omitempty"`
+	CpuCores         uint32       `protobuf:"varint,8,opt,name=cpuCores,proto3" json:"cpuCores,omitempty"`
+	Load1            float64      `protobuf:"fixed64,9,opt,name=load1,proto3" json:"load1,omitempty"`
+	Load5            float64      `protobuf:"fixed64,10,opt,name=load5,proto3" json:"load5,omitempty"`
+	Load15           float64      `protobuf:"fixed64,11,opt,name=load15,proto3" json:"load15,omitempty"`
+	NetRxBytesPerSec uint64       `protobuf:"varint,12,opt,name=netRxBytesPerSec,proto3" json:"netRxBytesPerSec,omitempty"`
+	NetTxBytesPerSec uint64       `protobuf:"varint,13,opt,name=netTxBytesPerSec,proto3" json:"netTxBytesPerSec,omitempty"`
+	Disks            []*DiskUsage `protobuf:"bytes,14,rep,name=disks,proto3" json:"disks,omitempty"`
 }
 
-func (x *ExerciseConfig) Reset() {
-	*x = ExerciseConfig{}
+func (x *Resources) Reset() {
+	*x = Resources{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_agent_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1564,13 +1683,13 @@ func (x *ExerciseConfig) Reset() {
 	}
 }
 
-func (x *ExerciseConfig) String() string {
+func (x *Resources) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ExerciseConfig) ProtoMessage() {}
+func (*Resources) ProtoMessage() {}
 
-func (x *ExerciseConfig) ProtoReflect() protoreflect.Message {
+func (x *Resources) ProtoReflect() protoreflect.Message {
 	mi := &file_agent_proto_msgTypes[26]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1582,145 +1701,139 @@ func (x *ExerciseConfig) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ExerciseConfig.ProtoReflect.Descriptor instead.
-func (*ExerciseConfig) Descriptor() ([]byte, []int) {
+// Deprecated: Use Resources.ProtoReflect.Descriptor instead.
+func (*Resources) Descriptor() ([]byte, []int) {
 	return file_agent_proto_rawDescGZIP(), []int{26}
 }
 
-func (x *ExerciseConfig) GetTag() string {
+func (x *Resources) GetMemAvailable() uint64 {
 	if x != nil {
-		return x.Tag
+		return x.MemAvailable
 	}
-	return ""
+	return 0
 }
 
-func (x *ExerciseConfig) GetStatic() bool {
+func (x *Resources) GetCpu() float64 {
 	if x != nil {
-		return x.Static
+		return x.Cpu
 	}
-	return false
+	return 0
 }
 
-func (x *ExerciseConfig) GetInstance() []*ExerciseInstanceConfig {
+func (x *Resources) GetMemPercentUsed() float64 {
 	if x != nil {
-		return x.Instance
+		return x.MemPercentUsed
 	}
-	return nil
+	return 0
 }
 
-type ExerciseInstanceConfig struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Image    string                `protobuf:"bytes,1,opt,name=image,proto3" json:"image,omitempty"`
-	Memory   uint32                `protobuf:"varint,2,opt,name=memory,proto3" json:"memory,omitempty"`
-	Cpu      float32               `protobuf:"fixed32,3,opt,name=cpu,proto3" json:"cpu,omitempty"`
-	Envs     []*EnvVarConfig       `protobuf:"bytes,4,rep,name=envs,proto3" json:"envs,omitempty"`
-	Children []*ChildrenChalConfig `protobuf:"bytes,5,rep,name=children,proto3" json:"children,omitempty"`
-	Records  []*RecordConfig       `protobuf:"bytes,6,rep,name=records,proto3" json:"records,omitempty"`
+func (x *Resources) GetLabCount() uint32 {
+	if x != nil {
+		return x.LabCount
+	}
+	return 0
 }
 
-func (x *ExerciseInstanceConfig) Reset() {
-	*x = ExerciseInstanceConfig{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_agent_proto_msgTypes[27]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *Resources) GetVmCount() uint32 {
+	if x != nil {
+		return x.VmCount
 	}
+	return 0
 }
 
-func (x *ExerciseInstanceConfig) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *Resources) GetContainerCount() uint32 {
+	if x != nil {
+		return x.ContainerCount
+	}
+	return 0
 }
 
-func (*ExerciseInstanceConfig) ProtoMessage() {}
-
-func (x *ExerciseInstanceConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_agent_proto_msgTypes[27]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *Resources) GetMemInstalled() uint64 {
+	if x != nil {
+		return x.MemInstalled
 	}
-	return mi.MessageOf(x)
+	return 0
 }
 
-// Deprecated: Use ExerciseInstanceConfig.ProtoReflect.Descriptor instead.
-func (*ExerciseInstanceConfig) Descriptor() ([]byte, []int) {
-	return file_agent_proto_rawDescGZIP(), []int{27}
+func (x *Resources) GetCpuCores() uint32 {
+	if x != nil {
+		return x.CpuCores
+	}
+	return 0
 }
 
-func (x *ExerciseInstanceConfig) GetImage() string {
+func (x *Resources) GetLoad1() float64 {
 	if x != nil {
-		return x.Image
+		return x.Load1
 	}
-	return ""
+	return 0
 }
 
-func (x *ExerciseInstanceConfig) GetMemory() uint32 {
+func (x *Resources) GetLoad5() float64 {
 	if x != nil {
-		return x.Memory
+		return x.Load5
 	}
 	return 0
 }
 
-func (x *ExerciseInstanceConfig) GetCpu() float32 {
+func (x *Resources) GetLoad15() float64 {
 	if x != nil {
-		return x.Cpu
+		return x.Load15
 	}
 	return 0
 }
 
-func (x *ExerciseInstanceConfig) GetEnvs() []*EnvVarConfig {
+func (x *Resources) GetNetRxBytesPerSec() uint64 {
 	if x != nil {
-		return x.Envs
+		return x.NetRxBytesPerSec
 	}
-	return nil
+	return 0
 }
 
-func (x *ExerciseInstanceConfig) GetChildren() []*ChildrenChalConfig {
+func (x *Resources) GetNetTxBytesPerSec() uint64 {
 	if x != nil {
-		return x.Children
+		return x.NetTxBytesPerSec
 	}
-	return nil
+	return 0
 }
 
-func (x *ExerciseInstanceConfig) GetRecords() []*RecordConfig {
+func (x *Resources) GetDisks() []*DiskUsage {
 	if x != nil {
-		return x.Records
+		return x.Disks
 	}
 	return nil
 }
 
-type EnvVarConfig struct {
+type DiskUsage struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name  string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Value string `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	// ova, filetransfer or docker
+	Name        string  `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Path        string  `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+	Total       uint64  `protobuf:"varint,3,opt,name=total,proto3" json:"total,omitempty"`
+	Used        uint64  `protobuf:"varint,4,opt,name=used,proto3" json:"used,omitempty"`
+	UsedPercent float64 `protobuf:"fixed64,5,opt,name=usedPercent,proto3" json:"usedPercent,omitempty"`
 }
 
-func (x *EnvVarConfig) Reset() {
-	*x = EnvVarConfig{}
+func (x *DiskUsage) Reset() {
+	*x = DiskUsage{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_agent_proto_msgTypes[28]
+		mi := &file_agent_proto_msgTypes[27]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *EnvVarConfig) String() string {
+func (x *DiskUsage) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EnvVarConfig) ProtoMessage() {}
+func (*DiskUsage) ProtoMessage() {}
 
-func (x *EnvVarConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_agent_proto_msgTypes[28]
+func (x *DiskUsage) ProtoReflect() protoreflect.Message {
+	mi := &file_agent_proto_msgTypes[27]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1731,53 +1844,83 @@ func (x *EnvVarConfig) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use EnvVarConfig.ProtoReflect.Descriptor instead.
-func (*EnvVarConfig) Descriptor() ([]byte, []int) {
-	return file_agent_proto_rawDescGZIP(), []int{28}
+// Deprecated: Use DiskUsage.ProtoReflect.Descriptor instead.
+func (*DiskUsage) Descriptor() ([]byte, []int) {
+	return file_agent_proto_rawDescGZIP(), []int{27}
 }
 
-func (x *EnvVarConfig) GetName() string {
+func (x *DiskUsage) GetName() string {
 	if x != nil {
 		return x.Name
 	}
 	return ""
 }
 
-func (x *EnvVarConfig) GetValue() string {
+func (x *DiskUsage) GetPath() string {
 	if x != nil {
-		return x.Value
+		return x.Path
 	}
 	return ""
 }
 
-type ChildrenChalConfig struct {
+func (x *DiskUsage) GetTotal() uint64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *DiskUsage) GetUsed() uint64 {
+	if x != nil {
+		return x.Used
+	}
+	return 0
+}
+
+func (x *DiskUsage) GetUsedPercent() float64 {
+	if x != nil {
+		return x.UsedPercent
+	}
+	return 0
+}
+
+// Resource usage of a lab, summed over its containers and VMs. CPU is in percent of a single core.
+// Network and block IO are counted from when the machines were started
+type LabUsage struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Tag     string `protobuf:"bytes,1,opt,name=tag,proto3" json:"tag,omitempty"`
-	Name    string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	EnvFlag string `protobuf:"bytes,3,opt,name=envFlag,proto3" json:"envFlag,omitempty"`
-	Static  string `protobuf:"bytes,4,opt,name=static,proto3" json:"static,omitempty"`
-}
-
-func (x *ChildrenChalConfig) Reset() {
-	*x = ChildrenChalConfig{}
+	LabTag          string  `protobuf:"bytes,1,opt,name=labTag,proto3" json:"labTag,omitempty"`
+	EventTag        string  `protobuf:"bytes,2,opt,name=eventTag,proto3" json:"eventTag,omitempty"`
+	CpuPercent      float64 `protobuf:"fixed64,3,opt,name=cpuPercent,proto3" json:"cpuPercent,omitempty"`
+	MemoryBytes     uint64  `protobuf:"varint,4,opt,name=memoryBytes,proto3" json:"memoryBytes,omitempty"`
+	Containers      uint32  `protobuf:"varint,5,opt,name=containers,proto3" json:"containers,omitempty"`
+	Vms             uint32  `protobuf:"varint,6,opt,name=vms,proto3" json:"vms,omitempty"`
+	SampledAt       int64   `protobuf:"varint,7,opt,name=sampledAt,proto3" json:"sampledAt,omitempty"`
+	NetRxBytes      uint64  `protobuf:"varint,8,opt,name=netRxBytes,proto3" json:"netRxBytes,omitempty"`
+	NetTxBytes      uint64  `protobuf:"varint,9,opt,name=netTxBytes,proto3" json:"netTxBytes,omitempty"`
+	BlockReadBytes  uint64  `protobuf:"varint,10,opt,name=blockReadBytes,proto3" json:"blockReadBytes,omitempty"`
+	BlockWriteBytes uint64  `protobuf:"varint,11,opt,name=blockWriteBytes,proto3" json:"blockWriteBytes,omitempty"`
+}
+
+func (x *LabUsage) Reset() {
+	*x = LabUsage{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_agent_proto_msgTypes[29]
+		mi := &file_agent_proto_msgTypes[28]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ChildrenChalConfig) String() string {
+func (x *LabUsage) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ChildrenChalConfig) ProtoMessage() {}
+func (*LabUsage) ProtoMessage() {}
 
-func (x *ChildrenChalConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_agent_proto_msgTypes[29]
+func (x *LabUsage) ProtoReflect() protoreflect.Message {
+	mi := &file_agent_proto_msgTypes[28]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1788,41 +1931,2038 @@ func (x *ChildrenChalConfig) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ChildrenChalConfig.ProtoReflect.Descriptor instead.
-func (*ChildrenChalConfig) Descriptor() ([]byte, []int) {
-	return file_agent_proto_rawDescGZIP(), []int{29}
+// Deprecated: Use LabUsage.ProtoReflect.Descriptor instead.
+func (*LabUsage) Descriptor() ([]byte, []int) {
+	return file_agent_proto_rawDescGZIP(), []int{28}
 }
 
-func (x *ChildrenChalConfig) GetTag() string {
+func (x *LabUsage) GetLabTag() string {
 	if x != nil {
-		return x.Tag
+		return x.LabTag
 	}
 	return ""
 }
 
-func (x *ChildrenChalConfig) GetName() string {
+func (x *LabUsage) GetEventTag() string {
 	if x != nil {
-		return x.Name
+		return x.EventTag
 	}
 	return ""
 }
 
-func (x *ChildrenChalConfig) GetEnvFlag() string {
+func (x *LabUsage) GetCpuPercent() float64 {
 	if x != nil {
-		return x.EnvFlag
+		return x.CpuPercent
 	}
-	return ""
+	return 0
 }
 
-func (x *ChildrenChalConfig) GetStatic() string {
+func (x *LabUsage) GetMemoryBytes() uint64 {
 	if x != nil {
-		return x.Static
+		return x.MemoryBytes
 	}
-	return ""
+	return 0
 }
 
-type RecordConfig struct {
-	state         protoimpl.MessageState
+func (x *LabUsage) GetContainers() uint32 {
+	if x != nil {
+		return x.Containers
+	}
+	return 0
+}
+
+func (x *LabUsage) GetVms() uint32 {
+	if x != nil {
+		return x.Vms
+	}
+	return 0
+}
+
+func (x *LabUsage) GetSampledAt() int64 {
+	if x != nil {
+		return x.SampledAt
+	}
+	return 0
+}
+
+func (x *LabUsage) GetNetRxBytes() uint64 {
+	if x != nil {
+		return x.NetRxBytes
+	}
+	return 0
+}
+
+func (x *LabUsage) GetNetTxBytes() uint64 {
+	if x != nil {
+		return x.NetTxBytes
+	}
+	return 0
+}
+
+func (x *LabUsage) GetBlockReadBytes() uint64 {
+	if x != nil {
+		return x.BlockReadBytes
+	}
+	return 0
+}
+
+func (x *LabUsage) GetBlockWriteBytes() uint64 {
+	if x != nil {
+		return x.BlockWriteBytes
+	}
+	return 0
+}
+
+// Leave out the tags to get the usage of every lab and event
+type LabUsageRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	EventTag string `protobuf:"bytes,1,opt,name=eventTag,proto3" json:"eventTag,omitempty"`
+	LabTag   string `protobuf:"bytes,2,opt,name=labTag,proto3" json:"labTag,omitempty"`
+}
+
+func (x *LabUsageRequest) Reset() {
+	*x = LabUsageRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_agent_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LabUsageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LabUsageRequest) ProtoMessage() {}
+
+func (x *LabUsageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_agent_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LabUsageRequest.ProtoReflect.Descriptor instead.
+func (*LabUsageRequest) Descriptor() ([]byte, []int) {
+	return file_agent_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *LabUsageRequest) GetEventTag() string {
+	if x != nil {
+		return x.EventTag
+	}
+	return ""
+}
+
+func (x *LabUsageRequest) GetLabTag() string {
+	if x != nil {
+		return x.LabTag
+	}
+	return ""
+}
+
+type LabUsageResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Labs   []*LabUsage   `protobuf:"bytes,1,rep,name=labs,proto3" json:"labs,omitempty"`
+	Events []*EventUsage `protobuf:"bytes,2,rep,name=events,proto3" json:"events,omitempty"`
+}
+
+func (x *LabUsageResponse) Reset() {
+	*x = LabUsageResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_agent_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LabUsageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LabUsageResponse) ProtoMessage() {}
+
+func (x *LabUsageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_agent_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LabUsageResponse.ProtoReflect.Descriptor instead.
+func (*LabUsageResponse) Descriptor() ([]byte, []int) {
+	return file_agent_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *LabUsageResponse) GetLabs() []*LabUsage {
+	if x != nil {
+		return x.Labs
+	}
+	return nil
+}
+
+func (x *LabUsageResponse) GetEvents() []*EventUsage {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+// Current usage of an event summed over its labs, and the totals since the agent started accounting for it.
+// Totals are kept after the event is closed
+type EventUsage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	EventTag        string       `protobuf:"bytes,1,opt,name=eventTag,proto3" json:"eventTag,omitempty"`
+	Labs            uint32       `protobuf:"varint,2,opt,name=labs,proto3" json:"labs,omitempty"`
+	CpuPercent      float64      `protobuf:"fixed64,3,opt,name=cpuPercent,proto3" json:"cpuPercent,omitempty"`
+	MemoryBytes     uint64       `protobuf:"varint,4,opt,name=memoryBytes,proto3" json:"memoryBytes,omitempty"`
+	NetRxBytes      uint64       `protobuf:"varint,5,opt,name=netRxBytes,proto3" json:"netRxBytes,omitempty"`
+	NetTxBytes      uint64       `protobuf:"varint,6,opt,name=netTxBytes,proto3" json:"netTxBytes,omitempty"`
+	BlockReadBytes  uint64       `protobuf:"varint,7,opt,name=blockReadBytes,proto3" json:"blockReadBytes,omitempty"`
+	BlockWriteBytes uint64       `protobuf:"varint,8,opt,name=blockWriteBytes,proto3" json:"blockWriteBytes,omitempty"`
+	Totals          *UsageTotals `protobuf:"bytes,9,opt,name=totals,proto3" json:"totals,omitempty"`
+}
+
+func (x *EventUsage) Reset() {
+	*x = EventUsage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_agent_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EventUsage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventUsage) ProtoMessage() {}
+
+func (x *EventUsage) ProtoReflect() protoreflect.Message {
+	mi := &file_agent_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EventUsage.ProtoReflect.Descriptor instead.
+func (*EventUsage) Descriptor() ([]byte, []int) {
+	return file_agent_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *EventUsage) GetEventTag() string {
+	if x != nil {
+		return x.EventTag
+	}
+	return ""
+}
+
+func (x *EventUsage) GetLabs() uint32 {
+	if x != nil {
+		return x.Labs
+	}
+	return 0
+}
+
+func (x *EventUsage) GetCpuPercent() float64 {
+	if x != nil {
+		return x.CpuPercent
+	}
+	return 0
+}
+
+func (x *EventUsage) GetMemoryBytes() uint64 {
+	if x != nil {
+		return x.MemoryBytes
+	}
+	return 0
+}
+
+func (x *EventUsage) GetNetRxBytes() uint64 {
+	if x != nil {
+		return x.NetRxBytes
+	}
+	return 0
+}
+
+func (x *EventUsage) GetNetTxBytes() uint64 {
+	if x != nil {
+		return x.NetTxBytes
+	}
+	return 0
+}
+
+func (x *EventUsage) GetBlockReadBytes() uint64 {
+	if x != nil {
+		return x.BlockReadBytes
+	}
+	return 0
+}
+
+func (x *EventUsage) GetBlockWriteBytes() uint64 {
+	if x != nil {
+		return x.BlockWriteBytes
+	}
+	return 0
+}
+
+func (x *EventUsage) GetTotals() *UsageTotals {
+	if x != nil {
+		return x.Totals
+	}
+	return nil
+}
+
+type UsageTotals struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CpuHours float64 `protobuf:"fixed64,1,opt,name=cpuHours,proto3" json:"cpuHours,omitempty"`
+	// GiB of memory used for an hour
+	MemoryGbHours   float64 `protobuf:"fixed64,2,opt,name=memoryGbHours,proto3" json:"memoryGbHours,omitempty"`
+	NetRxBytes      uint64  `protobuf:"varint,3,opt,name=netRxBytes,proto3" json:"netRxBytes,omitempty"`
+	NetTxBytes      uint64  `protobuf:"varint,4,opt,name=netTxBytes,proto3" json:"netTxBytes,omitempty"`
+	BlockReadBytes  uint64  `protobuf:"varint,5,opt,name=blockReadBytes,proto3" json:"blockReadBytes,omitempty"`
+	BlockWriteBytes uint64  `protobuf:"varint,6,opt,name=blockWriteBytes,proto3" json:"blockWriteBytes,omitempty"`
+	Since           int64   `protobuf:"varint,7,opt,name=since,proto3" json:"since,omitempty"`
+	UpdatedAt       int64   `protobuf:"varint,8,opt,name=updatedAt,proto3" json:"updatedAt,omitempty"`
+}
+
+func (x *UsageTotals) Reset() {
+	*x = UsageTotals{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_agent_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UsageTotals) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UsageTotals) ProtoMessage() {}
+
+func (x *UsageTotals) ProtoReflect() protoreflect.Message {
+	mi := &file_agent_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UsageTotals.ProtoReflect.Descriptor instead.
+func (*UsageTotals) Descriptor() ([]byte, []int) {
+	return file_agent_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *UsageTotals) GetCpuHours() float64 {
+	if x != nil {
+		return x.CpuHours
+	}
+	return 0
+}
+
+func (x *UsageTotals) GetMemoryGbHours() float64 {
+	if x != nil {
+		return x.MemoryGbHours
+	}
+	return 0
+}
+
+func (x *UsageTotals) GetNetRxBytes() uint64 {
+	if x != nil {
+		return x.NetRxBytes
+	}
+	return 0
+}
+
+func (x *UsageTotals) GetNetTxBytes() uint64 {
+	if x != nil {
+		return x.NetTxBytes
+	}
+	return 0
+}
+
+func (x *UsageTotals) GetBlockReadBytes() uint64 {
+	if x != nil {
+		return x.BlockReadBytes
+	}
+	return 0
+}
+
+func (x *UsageTotals) GetBlockWriteBytes() uint64 {
+	if x != nil {
+		return x.BlockWriteBytes
+	}
+	return 0
+}
+
+func (x *UsageTotals) GetSince() int64 {
+	if x != nil {
+		return x.Since
+	}
+	return 0
+}
+
+func (x *UsageTotals) GetUpdatedAt() int64 {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return 0
+}
+
+// Set when a resource of the host is above its configured threshold
+type ResourceWarning struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// cpu, memory, load or disk:<name>
+	Resource  string  `protobuf:"bytes,1,opt,name=resource,proto3" json:"resource,omitempty"`
+	Value     float64 `protobuf:"fixed64,2,opt,name=value,proto3" json:"value,omitempty"`
+	Threshold float64 `protobuf:"fixed64,3,opt,name=threshold,proto3" json:"threshold,omitempty"`
+}
+
+func (x *ResourceWarning) Reset() {
+	*x = ResourceWarning{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_agent_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ResourceWarning) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResourceWarning) ProtoMessage() {}
+
+func (x *ResourceWarning) ProtoReflect() protoreflect.Message {
+	mi := &file_agent_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResourceWarning.ProtoReflect.Descriptor instead.
+func (*ResourceWarning) Descriptor() ([]byte, []int) {
+	return file_agent_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *ResourceWarning) GetResource() string {
+	if x != nil {
+		return x.Resource
+	}
+	return ""
+}
+
+func (x *ResourceWarning) GetValue() float64 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+func (x *ResourceWarning) GetThreshold() float64 {
+	if x != nil {
+		return x.Threshold
+	}
+	return 0
+}
+
+type PingRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Ping string `protobuf:"bytes,1,opt,name=ping,proto3" json:"ping,omitempty"`
+}
+
+func (x *PingRequest) Reset() {
+	*x = PingRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_agent_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PingRequest) ProtoMessage() {}
+
+func (x *PingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_agent_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PingRequest.ProtoReflect.Descriptor instead.
+func (*PingRequest) Descriptor() ([]byte, []int) {
+	return file_agent_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *PingRequest) GetPing() string {
+	if x != nil {
+		return x.Ping
+	}
+	return ""
+}
+
+type PingResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Pong         string `protobuf:"bytes,1,opt,name=pong,proto3" json:"pong,omitempty"`
+	MemInstalled uint64 `protobuf:"varint,2,opt,name=memInstalled,proto3" json:"memInstalled,omitempty"`
+}
+
+func (x *PingResponse) Reset() {
+	*x = PingResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_agent_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PingResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PingResponse) ProtoMessage() {}
+
+func (x *PingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_agent_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PingResponse.ProtoReflect.Descriptor instead.
+func (*PingResponse) Descriptor() ([]byte, []int) {
+	return file_agent_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *PingResponse) GetPong() string {
+	if x != nil {
+		return x.Pong
+	}
+	return ""
+}
+
+func (x *PingResponse) GetMemInstalled() uint64 {
+	if x != nil {
+		return x.MemInstalled
+	}
+	return 0
+}
+
+type CreatEnvRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	EventTag        string            `protobuf:"bytes,1,opt,name=eventTag,proto3" json:"eventTag,omitempty"`
+	EnvType         int32             `protobuf:"varint,2,opt,name=envType,proto3" json:"envType,omitempty"`
+	Vm              *VmConfig         `protobuf:"bytes,3,opt,name=vm,proto3" json:"vm,omitempty"`
+	InitialLabs     int32             `protobuf:"varint,4,opt,name=initialLabs,proto3" json:"initialLabs,omitempty"`
+	Exercises       []string          `protobuf:"bytes,5,rep,name=exercises,proto3" json:"exercises,omitempty"`
+	TeamSize        int32             `protobuf:"varint,6,opt,name=teamSize,proto3" json:"teamSize,omitempty"`
+	ExerciseConfigs []*ExerciseConfig `protobuf:"bytes,7,rep,name=exerciseConfigs,proto3" json:"exerciseConfigs,omitempty"`
+	RecordSessions  bool              `protobuf:"varint,8,opt,name=recordSessions,proto3" json:"recordSessions,omitempty"`
+	DesktopPolicy   *DesktopPolicy    `protobuf:"bytes,9,opt,name=desktopPolicy,proto3" json:"desktopPolicy,omitempty"`
+}
+
+func (x *CreatEnvRequest) Reset() {
+	*x = CreatEnvRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_agent_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreatEnvRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatEnvRequest) ProtoMessage() {}
+
+func (x *CreatEnvRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_agent_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatEnvRequest.ProtoReflect.Descriptor instead.
+func (*CreatEnvRequest) Descriptor() ([]byte, []int) {
+	return file_agent_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *CreatEnvRequest) GetEventTag() string {
+	if x != nil {
+		return x.EventTag
+	}
+	return ""
+}
+
+func (x *CreatEnvRequest) GetEnvType() int32 {
+	if x != nil {
+		return x.EnvType
+	}
+	return 0
+}
+
+func (x *CreatEnvRequest) GetVm() *VmConfig {
+	if x != nil {
+		return x.Vm
+	}
+	return nil
+}
+
+func (x *CreatEnvRequest) GetInitialLabs() int32 {
+	if x != nil {
+		return x.InitialLabs
+	}
+	return 0
+}
+
+func (x *CreatEnvRequest) GetExercises() []string {
+	if x != nil {
+		return x.Exercises
+	}
+	return nil
+}
+
+func (x *CreatEnvRequest) GetTeamSize() int32 {
+	if x != nil {
+		return x.TeamSize
+	}
+	return 0
+}
+
+func (x *CreatEnvRequest) GetExerciseConfigs() []*ExerciseConfig {
+	if x != nil {
+		return x.ExerciseConfigs
+	}
+	return nil
+}
+
+func (x *CreatEnvRequest) GetRecordSessions() bool {
+	if x != nil {
+		return x.RecordSessions
+	}
+	return false
+}
+
+func (x *CreatEnvRequest) GetDesktopPolicy() *DesktopPolicy {
+	if x != nil {
+		return x.DesktopPolicy
+	}
+	return nil
+}
+
+type DesktopPolicy struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DisableCopy      bool   `protobuf:"varint,1,opt,name=disableCopy,proto3" json:"disableCopy,omitempty"`
+	DisablePaste     bool   `protobuf:"varint,2,opt,name=disablePaste,proto3" json:"disablePaste,omitempty"`
+	DisableDrive     bool   `protobuf:"varint,3,opt,name=disableDrive,proto3" json:"disableDrive,omitempty"`
+	DisableWallpaper bool   `protobuf:"varint,4,opt,name=disableWallpaper,proto3" json:"disableWallpaper,omitempty"`
+	DisableAudio     bool   `protobuf:"varint,5,opt,name=disableAudio,proto3" json:"disableAudio,omitempty"`
+	Width            uint32 `protobuf:"varint,6,opt,name=width,proto3" json:"width,omitempty"`
+	Height           uint32 `protobuf:"varint,7,opt,name=height,proto3" json:"height,omitempty"`
+	ColorDepth       uint32 `protobuf:"varint,8,opt,name=colorDepth,proto3" json:"colorDepth,omitempty"`
+}
+
+func (x *DesktopPolicy) Reset() {
+	*x = DesktopPolicy{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_agent_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DesktopPolicy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DesktopPolicy) ProtoMessage() {}
+
+func (x *DesktopPolicy) ProtoReflect() protoreflect.Message {
+	mi := &file_agent_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DesktopPolicy.ProtoReflect.Descriptor instead.
+func (*DesktopPolicy) Descriptor() ([]byte, []int) {
+	return file_agent_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *DesktopPolicy) GetDisableCopy() bool {
+	if x != nil {
+		return x.DisableCopy
+	}
+	return false
+}
+
+func (x *DesktopPolicy) GetDisablePaste() bool {
+	if x != nil {
+		return x.DisablePaste
+	}
+	return false
+}
+
+func (x *DesktopPolicy) GetDisableDrive() bool {
+	if x != nil {
+		return x.DisableDrive
+	}
+	return false
+}
+
+func (x *DesktopPolicy) GetDisableWallpaper() bool {
+	if x != nil {
+		return x.DisableWallpaper
+	}
+	return false
+}
+
+func (x *DesktopPolicy) GetDisableAudio() bool {
+	if x != nil {
+		return x.DisableAudio
+	}
+	return false
+}
+
+func (x *DesktopPolicy) GetWidth() uint32 {
+	if x != nil {
+		return x.Width
+	}
+	return 0
+}
+
+func (x *DesktopPolicy) GetHeight() uint32 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *DesktopPolicy) GetColorDepth() uint32 {
+	if x != nil {
+		return x.ColorDepth
+	}
+	return 0
+}
+
+type CloseEnvRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	EventTag string `protobuf:"bytes,1,opt,name=eventTag,proto3" json:"eventTag,omitempty"`
+}
+
+func (x *CloseEnvRequest) Reset() {
+	*x = CloseEnvRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_agent_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CloseEnvRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CloseEnvRequest) ProtoMessage() {}
+
+func (x *CloseEnvRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_agent_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CloseEnvRequest.ProtoReflect.Descriptor instead.
+func (*CloseEnvRequest) Descriptor() ([]byte, []int) {
+	return file_agent_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *CloseEnvRequest) GetEventTag() string {
+	if x != nil {
+		return x.EventTag
+	}
+	return ""
+}
+
+type ListEnvResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	EventTags         map[string]bool `protobuf:"bytes,1,rep,name=eventTags,proto3" json:"eventTags,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	StartingEventTags map[string]bool `protobuf:"bytes,2,rep,name=startingEventTags,proto3" json:"startingEventTags,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	ClosingEventTags  map[string]bool `protobuf:"bytes,3,rep,name=closingEventTags,proto3" json:"closingEventTags,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+}
+
+func (x *ListEnvResponse) Reset() {
+	*x = ListEnvResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_agent_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListEnvResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListEnvResponse) ProtoMessage() {}
+
+func (x *ListEnvResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_agent_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListEnvResponse.ProtoReflect.Descriptor instead.
+func (*ListEnvResponse) Descriptor() ([]byte, []int) {
+	return file_agent_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *ListEnvResponse) GetEventTags() map[string]bool {
+	if x != nil {
+		return x.EventTags
+	}
+	return nil
+}
+
+func (x *ListEnvResponse) GetStartingEventTags() map[string]bool {
+	if x != nil {
+		return x.StartingEventTags
+	}
+	return nil
+}
+
+func (x *ListEnvResponse) GetClosingEventTags() map[string]bool {
+	if x != nil {
+		return x.ClosingEventTags
+	}
+	return nil
+}
+
+type CreateLabRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	EventTag string `protobuf:"bytes,1,opt,name=eventTag,proto3" json:"eventTag,omitempty"`
+	IsVPN    bool   `protobuf:"varint,2,opt,name=isVPN,proto3" json:"isVPN,omitempty"`
+}
+
+func (x *CreateLabRequest) Reset() {
+	*x = CreateLabRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_agent_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateLabRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateLabRequest) ProtoMessage() {}
+
+func (x *CreateLabRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_agent_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateLabRequest.ProtoReflect.Descriptor instead.
+func (*CreateLabRequest) Descriptor() ([]byte, []int) {
+	return file_agent_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *CreateLabRequest) GetEventTag() string {
+	if x != nil {
+		return x.EventTag
+	}
+	return ""
+}
+
+func (x *CreateLabRequest) GetIsVPN() bool {
+	if x != nil {
+		return x.IsVPN
+	}
+	return false
+}
+
+type CreateVpnConfRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	LabTag string `protobuf:"bytes,1,opt,name=labTag,proto3" json:"labTag,omitempty"`
+}
+
+func (x *CreateVpnConfRequest) Reset() {
+	*x = CreateVpnConfRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_agent_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateVpnConfRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateVpnConfRequest) ProtoMessage() {}
+
+func (x *CreateVpnConfRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_agent_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateVpnConfRequest.ProtoReflect.Descriptor instead.
+func (*CreateVpnConfRequest) Descriptor() ([]byte, []int) {
+	return file_agent_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *CreateVpnConfRequest) GetLabTag() string {
+	if x != nil {
+		return x.LabTag
+	}
+	return ""
+}
+
+type CreateVpnConfResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Configs []string `protobuf:"bytes,1,rep,name=configs,proto3" json:"configs,omitempty"`
+}
+
+func (x *CreateVpnConfResponse) Reset() {
+	*x = CreateVpnConfResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_agent_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateVpnConfResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateVpnConfResponse) ProtoMessage() {}
+
+func (x *CreateVpnConfResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_agent_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateVpnConfResponse.ProtoReflect.Descriptor instead.
+func (*CreateVpnConfResponse) Descriptor() ([]byte, []int) {
+	return file_agent_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *CreateVpnConfResponse) GetConfigs() []string {
+	if x != nil {
+		return x.Configs
+	}
+	return nil
+}
+
+type CloseLabRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	LabTag string `protobuf:"bytes,1,opt,name=labTag,proto3" json:"labTag,omitempty"`
+}
+
+func (x *CloseLabRequest) Reset() {
+	*x = CloseLabRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_agent_proto_msgTypes[43]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CloseLabRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CloseLabRequest) ProtoMessage() {}
+
+func (x *CloseLabRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_agent_proto_msgTypes[43]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CloseLabRequest.ProtoReflect.Descriptor instead.
+func (*CloseLabRequest) Descriptor() ([]byte, []int) {
+	return file_agent_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *CloseLabRequest) GetLabTag() string {
+	if x != nil {
+		return x.LabTag
+	}
+	return ""
+}
+
+type ExerciseRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	LabTag          string            `protobuf:"bytes,1,opt,name=labTag,proto3" json:"labTag,omitempty"`
+	EnvTag          string            `protobuf:"bytes,2,opt,name=envTag,proto3" json:"envTag,omitempty"`
+	Exercises       []string          `protobuf:"bytes,3,rep,name=exercises,proto3" json:"exercises,omitempty"`
+	Exercise        string            `protobuf:"bytes,4,opt,name=exercise,proto3" json:"exercise,omitempty"`
+	ExerciseConfigs []*ExerciseConfig `protobuf:"bytes,5,rep,name=exerciseConfigs,proto3" json:"exerciseConfigs,omitempty"`
+}
+
+func (x *ExerciseRequest) Reset() {
+	*x = ExerciseRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_agent_proto_msgTypes[44]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExerciseRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExerciseRequest) ProtoMessage() {}
+
+func (x *ExerciseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_agent_proto_msgTypes[44]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExerciseRequest.ProtoReflect.Descriptor instead.
+func (*ExerciseRequest) Descriptor() ([]byte, []int) {
+	return file_agent_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *ExerciseRequest) GetLabTag() string {
+	if x != nil {
+		return x.LabTag
+	}
+	return ""
+}
+
+func (x *ExerciseRequest) GetEnvTag() string {
+	if x != nil {
+		return x.EnvTag
+	}
+	return ""
+}
+
+func (x *ExerciseRequest) GetExercises() []string {
+	if x != nil {
+		return x.Exercises
+	}
+	return nil
+}
+
+func (x *ExerciseRequest) GetExercise() string {
+	if x != nil {
+		return x.Exercise
+	}
+	return ""
+}
+
+func (x *ExerciseRequest) GetExerciseConfigs() []*ExerciseConfig {
+	if x != nil {
+		return x.ExerciseConfigs
+	}
+	return nil
+}
+
+type VmConfig struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Image    string  `protobuf:"bytes,1,opt,name=image,proto3" json:"image,omitempty"`
+	MemoryMB uint32  `protobuf:"varint,2,opt,name=memoryMB,proto3" json:"memoryMB,omitempty"`
+	Cpu      float64 `protobuf:"fixed64,3,opt,name=cpu,proto3" json:"cpu,omitempty"`
+}
+
+func (x *VmConfig) Reset() {
+	*x = VmConfig{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_agent_proto_msgTypes[45]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VmConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VmConfig) ProtoMessage() {}
+
+func (x *VmConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_agent_proto_msgTypes[45]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VmConfig.ProtoReflect.Descriptor instead.
+func (*VmConfig) Descriptor() ([]byte, []int) {
+	return file_agent_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *VmConfig) GetImage() string {
+	if x != nil {
+		return x.Image
+	}
+	return ""
+}
+
+func (x *VmConfig) GetMemoryMB() uint32 {
+	if x != nil {
+		return x.MemoryMB
+	}
+	return 0
+}
+
+func (x *VmConfig) GetCpu() float64 {
+	if x != nil {
+		return x.Cpu
+	}
+	return 0
+}
+
+type StatusResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Message string `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (x *StatusResponse) Reset() {
+	*x = StatusResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_agent_proto_msgTypes[46]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StatusResponse) ProtoMessage() {}
+
+func (x *StatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_agent_proto_msgTypes[46]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StatusResponse.ProtoReflect.Descriptor instead.
+func (*StatusResponse) Descriptor() ([]byte, []int) {
+	return file_agent_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *StatusResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type Lab struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Tag             string            `protobuf:"bytes,1,opt,name=tag,proto3" json:"tag,omitempty"`
+	EventTag        string            `protobuf:"bytes,2,opt,name=eventTag,proto3" json:"eventTag,omitempty"`
+	Exercises       []*Exercise       `protobuf:"bytes,3,rep,name=exercises,proto3" json:"exercises,omitempty"`
+	IsVPN           bool              `protobuf:"varint,4,opt,name=isVPN,proto3" json:"isVPN,omitempty"`
+	GuacCreds       *GuacCreds        `protobuf:"bytes,5,opt,name=guacCreds,proto3" json:"guacCreds,omitempty"`
+	VpnConfs        []string          `protobuf:"bytes,6,rep,name=vpnConfs,proto3" json:"vpnConfs,omitempty"`
+	MemberCreds     []*GuacCreds      `protobuf:"bytes,7,rep,name=memberCreds,proto3" json:"memberCreds,omitempty"`
+	ExposedServices []*ExposedService `protobuf:"bytes,8,rep,name=exposedServices,proto3" json:"exposedServices,omitempty"`
+	// Attempts it took to create the lab
+	Attempts int32 `protobuf:"varint,9,opt,name=attempts,proto3" json:"attempts,omitempty"`
+}
+
+func (x *Lab) Reset() {
+	*x = Lab{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_agent_proto_msgTypes[47]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Lab) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Lab) ProtoMessage() {}
+
+func (x *Lab) ProtoReflect() protoreflect.Message {
+	mi := &file_agent_proto_msgTypes[47]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Lab.ProtoReflect.Descriptor instead.
+func (*Lab) Descriptor() ([]byte, []int) {
+	return file_agent_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *Lab) GetTag() string {
+	if x != nil {
+		return x.Tag
+	}
+	return ""
+}
+
+func (x *Lab) GetEventTag() string {
+	if x != nil {
+		return x.EventTag
+	}
+	return ""
+}
+
+func (x *Lab) GetExercises() []*Exercise {
+	if x != nil {
+		return x.Exercises
+	}
+	return nil
+}
+
+func (x *Lab) GetIsVPN() bool {
+	if x != nil {
+		return x.IsVPN
+	}
+	return false
+}
+
+func (x *Lab) GetGuacCreds() *GuacCreds {
+	if x != nil {
+		return x.GuacCreds
+	}
+	return nil
+}
+
+func (x *Lab) GetVpnConfs() []string {
+	if x != nil {
+		return x.VpnConfs
+	}
+	return nil
+}
+
+func (x *Lab) GetMemberCreds() []*GuacCreds {
+	if x != nil {
+		return x.MemberCreds
+	}
+	return nil
+}
+
+func (x *Lab) GetExposedServices() []*ExposedService {
+	if x != nil {
+		return x.ExposedServices
+	}
+	return nil
+}
+
+func (x *Lab) GetAttempts() int32 {
+	if x != nil {
+		return x.Attempts
+	}
+	return 0
+}
+
+type ExposedService struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ExerciseTag string `protobuf:"bytes,1,opt,name=exerciseTag,proto3" json:"exerciseTag,omitempty"`
+	Name        string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Url         string `protobuf:"bytes,3,opt,name=url,proto3" json:"url,omitempty"`
+}
+
+func (x *ExposedService) Reset() {
+	*x = ExposedService{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_agent_proto_msgTypes[48]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExposedService) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExposedService) ProtoMessage() {}
+
+func (x *ExposedService) ProtoReflect() protoreflect.Message {
+	mi := &file_agent_proto_msgTypes[48]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExposedService.ProtoReflect.Descriptor instead.
+func (*ExposedService) Descriptor() ([]byte, []int) {
+	return file_agent_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *ExposedService) GetExerciseTag() string {
+	if x != nil {
+		return x.ExerciseTag
+	}
+	return ""
+}
+
+func (x *ExposedService) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ExposedService) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+type Exercise struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Tag            string           `protobuf:"bytes,1,opt,name=tag,proto3" json:"tag,omitempty"`
+	ChildExercises []*ChildExercise `protobuf:"bytes,2,rep,name=childExercises,proto3" json:"childExercises,omitempty"`
+	Machines       []*Machine       `protobuf:"bytes,3,rep,name=machines,proto3" json:"machines,omitempty"`
+}
+
+func (x *Exercise) Reset() {
+	*x = Exercise{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_agent_proto_msgTypes[49]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Exercise) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Exercise) ProtoMessage() {}
+
+func (x *Exercise) ProtoReflect() protoreflect.Message {
+	mi := &file_agent_proto_msgTypes[49]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Exercise.ProtoReflect.Descriptor instead.
+func (*Exercise) Descriptor() ([]byte, []int) {
+	return file_agent_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *Exercise) GetTag() string {
+	if x != nil {
+		return x.Tag
+	}
+	return ""
+}
+
+func (x *Exercise) GetChildExercises() []*ChildExercise {
+	if x != nil {
+		return x.ChildExercises
+	}
+	return nil
+}
+
+func (x *Exercise) GetMachines() []*Machine {
+	if x != nil {
+		return x.Machines
+	}
+	return nil
+}
+
+type ChildExercise struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Tag  string `protobuf:"bytes,1,opt,name=tag,proto3" json:"tag,omitempty"`
+	Flag string `protobuf:"bytes,2,opt,name=flag,proto3" json:"flag,omitempty"`
+}
+
+func (x *ChildExercise) Reset() {
+	*x = ChildExercise{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_agent_proto_msgTypes[50]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ChildExercise) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChildExercise) ProtoMessage() {}
+
+func (x *ChildExercise) ProtoReflect() protoreflect.Message {
+	mi := &file_agent_proto_msgTypes[50]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChildExercise.ProtoReflect.Descriptor instead.
+func (*ChildExercise) Descriptor() ([]byte, []int) {
+	return file_agent_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *ChildExercise) GetTag() string {
+	if x != nil {
+		return x.Tag
+	}
+	return ""
+}
+
+func (x *ChildExercise) GetFlag() string {
+	if x != nil {
+		return x.Flag
+	}
+	return ""
+}
+
+type Machine struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id     string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Status string   `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	Image  string   `protobuf:"bytes,3,opt,name=image,proto3" json:"image,omitempty"`
+	Errors []string `protobuf:"bytes,4,rep,name=errors,proto3" json:"errors,omitempty"`
+	Type   string   `protobuf:"bytes,5,opt,name=type,proto3" json:"type,omitempty"`
+}
+
+func (x *Machine) Reset() {
+	*x = Machine{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_agent_proto_msgTypes[51]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Machine) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Machine) ProtoMessage() {}
+
+func (x *Machine) ProtoReflect() protoreflect.Message {
+	mi := &file_agent_proto_msgTypes[51]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Machine.ProtoReflect.Descriptor instead.
+func (*Machine) Descriptor() ([]byte, []int) {
+	return file_agent_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *Machine) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Machine) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *Machine) GetImage() string {
+	if x != nil {
+		return x.Image
+	}
+	return ""
+}
+
+func (x *Machine) GetErrors() []string {
+	if x != nil {
+		return x.Errors
+	}
+	return nil
+}
+
+func (x *Machine) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+type GuacCreds struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Username string `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	Password string `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
+	// Only set on member credentials, which give access to a single frontend
+	RdpPort       uint32 `protobuf:"varint,3,opt,name=rdpPort,proto3" json:"rdpPort,omitempty"`
+	FrontendImage string `protobuf:"bytes,4,opt,name=frontendImage,proto3" json:"frontendImage,omitempty"`
+}
+
+func (x *GuacCreds) Reset() {
+	*x = GuacCreds{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_agent_proto_msgTypes[52]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GuacCreds) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GuacCreds) ProtoMessage() {}
+
+func (x *GuacCreds) ProtoReflect() protoreflect.Message {
+	mi := &file_agent_proto_msgTypes[52]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GuacCreds.ProtoReflect.Descriptor instead.
+func (*GuacCreds) Descriptor() ([]byte, []int) {
+	return file_agent_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *GuacCreds) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *GuacCreds) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+func (x *GuacCreds) GetRdpPort() uint32 {
+	if x != nil {
+		return x.RdpPort
+	}
+	return 0
+}
+
+func (x *GuacCreds) GetFrontendImage() string {
+	if x != nil {
+		return x.FrontendImage
+	}
+	return ""
+}
+
+type ExerciseConfig struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Tag      string                    `protobuf:"bytes,1,opt,name=tag,proto3" json:"tag,omitempty"`
+	Static   bool                      `protobuf:"varint,2,opt,name=static,proto3" json:"static,omitempty"`
+	Instance []*ExerciseInstanceConfig `protobuf:"bytes,3,rep,name=instance,proto3" json:"instance,omitempty"`
+}
+
+func (x *ExerciseConfig) Reset() {
+	*x = ExerciseConfig{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_agent_proto_msgTypes[53]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExerciseConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExerciseConfig) ProtoMessage() {}
+
+func (x *ExerciseConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_agent_proto_msgTypes[53]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExerciseConfig.ProtoReflect.Descriptor instead.
+func (*ExerciseConfig) Descriptor() ([]byte, []int) {
+	return file_agent_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *ExerciseConfig) GetTag() string {
+	if x != nil {
+		return x.Tag
+	}
+	return ""
+}
+
+func (x *ExerciseConfig) GetStatic() bool {
+	if x != nil {
+		return x.Static
+	}
+	return false
+}
+
+func (x *ExerciseConfig) GetInstance() []*ExerciseInstanceConfig {
+	if x != nil {
+		return x.Instance
+	}
+	return nil
+}
+
+type ExerciseInstanceConfig struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Image      string                `protobuf:"bytes,1,opt,name=image,proto3" json:"image,omitempty"`
+	Memory     uint32                `protobuf:"varint,2,opt,name=memory,proto3" json:"memory,omitempty"`
+	Cpu        float32               `protobuf:"fixed32,3,opt,name=cpu,proto3" json:"cpu,omitempty"`
+	Envs       []*EnvVarConfig       `protobuf:"bytes,4,rep,name=envs,proto3" json:"envs,omitempty"`
+	Children   []*ChildrenChalConfig `protobuf:"bytes,5,rep,name=children,proto3" json:"children,omitempty"`
+	Records    []*RecordConfig       `protobuf:"bytes,6,rep,name=records,proto3" json:"records,omitempty"`
+	Expose     bool                  `protobuf:"varint,7,opt,name=expose,proto3" json:"expose,omitempty"`
+	ExposePort uint32                `protobuf:"varint,8,opt,name=exposePort,proto3" json:"exposePort,omitempty"`
+	Terminal   bool                  `protobuf:"varint,9,opt,name=terminal,proto3" json:"terminal,omitempty"`
+}
+
+func (x *ExerciseInstanceConfig) Reset() {
+	*x = ExerciseInstanceConfig{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_agent_proto_msgTypes[54]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExerciseInstanceConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExerciseInstanceConfig) ProtoMessage() {}
+
+func (x *ExerciseInstanceConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_agent_proto_msgTypes[54]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExerciseInstanceConfig.ProtoReflect.Descriptor instead.
+func (*ExerciseInstanceConfig) Descriptor() ([]byte, []int) {
+	return file_agent_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *ExerciseInstanceConfig) GetImage() string {
+	if x != nil {
+		return x.Image
+	}
+	return ""
+}
+
+func (x *ExerciseInstanceConfig) GetMemory() uint32 {
+	if x != nil {
+		return x.Memory
+	}
+	return 0
+}
+
+func (x *ExerciseInstanceConfig) GetCpu() float32 {
+	if x != nil {
+		return x.Cpu
+	}
+	return 0
+}
+
+func (x *ExerciseInstanceConfig) GetEnvs() []*EnvVarConfig {
+	if x != nil {
+		return x.Envs
+	}
+	return nil
+}
+
+func (x *ExerciseInstanceConfig) GetChildren() []*ChildrenChalConfig {
+	if x != nil {
+		return x.Children
+	}
+	return nil
+}
+
+func (x *ExerciseInstanceConfig) GetRecords() []*RecordConfig {
+	if x != nil {
+		return x.Records
+	}
+	return nil
+}
+
+func (x *ExerciseInstanceConfig) GetExpose() bool {
+	if x != nil {
+		return x.Expose
+	}
+	return false
+}
+
+func (x *ExerciseInstanceConfig) GetExposePort() uint32 {
+	if x != nil {
+		return x.ExposePort
+	}
+	return 0
+}
+
+func (x *ExerciseInstanceConfig) GetTerminal() bool {
+	if x != nil {
+		return x.Terminal
+	}
+	return false
+}
+
+type EnvVarConfig struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name  string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Value string `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (x *EnvVarConfig) Reset() {
+	*x = EnvVarConfig{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_agent_proto_msgTypes[55]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EnvVarConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EnvVarConfig) ProtoMessage() {}
+
+func (x *EnvVarConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_agent_proto_msgTypes[55]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EnvVarConfig.ProtoReflect.Descriptor instead.
+func (*EnvVarConfig) Descriptor() ([]byte, []int) {
+	return file_agent_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *EnvVarConfig) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *EnvVarConfig) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+type ChildrenChalConfig struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Tag     string `protobuf:"bytes,1,opt,name=tag,proto3" json:"tag,omitempty"`
+	Name    string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	EnvFlag string `protobuf:"bytes,3,opt,name=envFlag,proto3" json:"envFlag,omitempty"`
+	Static  string `protobuf:"bytes,4,opt,name=static,proto3" json:"static,omitempty"`
+}
+
+func (x *ChildrenChalConfig) Reset() {
+	*x = ChildrenChalConfig{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_agent_proto_msgTypes[56]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ChildrenChalConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChildrenChalConfig) ProtoMessage() {}
+
+func (x *ChildrenChalConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_agent_proto_msgTypes[56]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChildrenChalConfig.ProtoReflect.Descriptor instead.
+func (*ChildrenChalConfig) Descriptor() ([]byte, []int) {
+	return file_agent_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *ChildrenChalConfig) GetTag() string {
+	if x != nil {
+		return x.Tag
+	}
+	return ""
+}
+
+func (x *ChildrenChalConfig) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ChildrenChalConfig) GetEnvFlag() string {
+	if x != nil {
+		return x.EnvFlag
+	}
+	return ""
+}
+
+func (x *ChildrenChalConfig) GetStatic() string {
+	if x != nil {
+		return x.Static
+	}
+	return ""
+}
+
+type RecordConfig struct {
+	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
@@ -1834,7 +3974,7 @@ type RecordConfig struct {
 func (x *RecordConfig) Reset() {
 	*x = RecordConfig{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_agent_proto_msgTypes[30]
+		mi := &file_agent_proto_msgTypes[57]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1847,447 +3987,1045 @@ func (x *RecordConfig) String() string {
 func (*RecordConfig) ProtoMessage() {}
 
 func (x *RecordConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_agent_proto_msgTypes[30]
+	mi := &file_agent_proto_msgTypes[57]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
 		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use RecordConfig.ProtoReflect.Descriptor instead.
-func (*RecordConfig) Descriptor() ([]byte, []int) {
-	return file_agent_proto_rawDescGZIP(), []int{30}
-}
-
-func (x *RecordConfig) GetType() string {
-	if x != nil {
-		return x.Type
-	}
-	return ""
-}
-
-func (x *RecordConfig) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
-}
-
-func (x *RecordConfig) GetData() string {
-	if x != nil {
-		return x.Data
-	}
-	return ""
-}
-
-var File_agent_proto protoreflect.FileDescriptor
-
-var file_agent_proto_rawDesc = []byte{
-	0x0a, 0x0b, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x05, 0x61,
-	0x67, 0x65, 0x6e, 0x74, 0x22, 0x07, 0x0a, 0x05, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x57, 0x0a,
-	0x09, 0x56, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x6c, 0x61,
-	0x62, 0x54, 0x61, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6c, 0x61, 0x62, 0x54,
-	0x61, 0x67, 0x12, 0x32, 0x0a, 0x14, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
-	0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x14, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x65, 0x6e,
-	0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x22, 0x29, 0x0a, 0x0f, 0x52, 0x65, 0x73, 0x65, 0x74, 0x4c,
-	0x61, 0x62, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x6c, 0x61, 0x62,
-	0x54, 0x61, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6c, 0x61, 0x62, 0x54, 0x61,
-	0x67, 0x22, 0x27, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x4c, 0x61, 0x62, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x6c, 0x61, 0x62, 0x54, 0x61, 0x67, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x06, 0x6c, 0x61, 0x62, 0x54, 0x61, 0x67, 0x22, 0x2e, 0x0a, 0x0e, 0x47, 0x65,
-	0x74, 0x4c, 0x61, 0x62, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1c, 0x0a, 0x03,
-	0x6c, 0x61, 0x62, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x61, 0x67, 0x65, 0x6e,
-	0x74, 0x2e, 0x4c, 0x61, 0x62, 0x52, 0x03, 0x6c, 0x61, 0x62, 0x22, 0x29, 0x0a, 0x0f, 0x47, 0x65,
-	0x74, 0x48, 0x6f, 0x73, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a,
-	0x06, 0x6c, 0x61, 0x62, 0x54, 0x61, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6c,
-	0x61, 0x62, 0x54, 0x61, 0x67, 0x22, 0x28, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x48, 0x6f, 0x73, 0x74,
-	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x68, 0x6f, 0x73,
-	0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x68, 0x6f, 0x73, 0x74, 0x73, 0x22,
-	0x99, 0x01, 0x0a, 0x0f, 0x4d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x68, 0x62, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x02, 0x68, 0x62, 0x12, 0x24, 0x0a, 0x07, 0x6e, 0x65, 0x77, 0x4c, 0x61, 0x62, 0x73, 0x18, 0x02,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x4c, 0x61, 0x62,
-	0x52, 0x07, 0x6e, 0x65, 0x77, 0x4c, 0x61, 0x62, 0x73, 0x12, 0x2e, 0x0a, 0x09, 0x72, 0x65, 0x73,
-	0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x61,
-	0x67, 0x65, 0x6e, 0x74, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x52, 0x09,
-	0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x71, 0x75, 0x65,
-	0x75, 0x65, 0x64, 0x54, 0x61, 0x73, 0x6b, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b,
-	0x71, 0x75, 0x65, 0x75, 0x65, 0x64, 0x54, 0x61, 0x73, 0x6b, 0x73, 0x22, 0xeb, 0x01, 0x0a, 0x09,
-	0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x12, 0x22, 0x0a, 0x0c, 0x6d, 0x65, 0x6d,
-	0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
-	0x0c, 0x6d, 0x65, 0x6d, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x10, 0x0a,
-	0x03, 0x63, 0x70, 0x75, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x03, 0x63, 0x70, 0x75, 0x12,
-	0x26, 0x0a, 0x0e, 0x6d, 0x65, 0x6d, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x55, 0x73, 0x65,
-	0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0e, 0x6d, 0x65, 0x6d, 0x50, 0x65, 0x72, 0x63,
-	0x65, 0x6e, 0x74, 0x55, 0x73, 0x65, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x61, 0x62, 0x43, 0x6f,
-	0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x6c, 0x61, 0x62, 0x43, 0x6f,
-	0x75, 0x6e, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x6d, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05,
-	0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x76, 0x6d, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x26, 0x0a,
-	0x0e, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18,
-	0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0e, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72,
-	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x22, 0x0a, 0x0c, 0x6d, 0x65, 0x6d, 0x49, 0x6e, 0x73, 0x74,
-	0x61, 0x6c, 0x6c, 0x65, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x6d, 0x65, 0x6d,
-	0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x22, 0x21, 0x0a, 0x0b, 0x50, 0x69, 0x6e,
-	0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x69, 0x6e, 0x67,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x69, 0x6e, 0x67, 0x22, 0x46, 0x0a, 0x0c,
-	0x50, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04,
-	0x70, 0x6f, 0x6e, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x6f, 0x6e, 0x67,
-	0x12, 0x22, 0x0a, 0x0c, 0x6d, 0x65, 0x6d, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x6d, 0x65, 0x6d, 0x49, 0x6e, 0x73, 0x74, 0x61,
-	0x6c, 0x6c, 0x65, 0x64, 0x22, 0x85, 0x02, 0x0a, 0x0f, 0x43, 0x72, 0x65, 0x61, 0x74, 0x45, 0x6e,
-	0x76, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x65, 0x76, 0x65, 0x6e,
-	0x74, 0x54, 0x61, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x65, 0x76, 0x65, 0x6e,
-	0x74, 0x54, 0x61, 0x67, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e, 0x76, 0x54, 0x79, 0x70, 0x65, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x65, 0x6e, 0x76, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1f,
-	0x0a, 0x02, 0x76, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x61, 0x67, 0x65,
-	0x6e, 0x74, 0x2e, 0x56, 0x6d, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x02, 0x76, 0x6d, 0x12,
-	0x20, 0x0a, 0x0b, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x4c, 0x61, 0x62, 0x73, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x4c, 0x61, 0x62,
-	0x73, 0x12, 0x1c, 0x0a, 0x09, 0x65, 0x78, 0x65, 0x72, 0x63, 0x69, 0x73, 0x65, 0x73, 0x18, 0x05,
-	0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x65, 0x78, 0x65, 0x72, 0x63, 0x69, 0x73, 0x65, 0x73, 0x12,
-	0x1a, 0x0a, 0x08, 0x74, 0x65, 0x61, 0x6d, 0x53, 0x69, 0x7a, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28,
-	0x05, 0x52, 0x08, 0x74, 0x65, 0x61, 0x6d, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x3f, 0x0a, 0x0f, 0x65,
-	0x78, 0x65, 0x72, 0x63, 0x69, 0x73, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x18, 0x07,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x45, 0x78, 0x65,
-	0x72, 0x63, 0x69, 0x73, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x0f, 0x65, 0x78, 0x65,
-	0x72, 0x63, 0x69, 0x73, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x22, 0x2d, 0x0a, 0x0f,
-	0x43, 0x6c, 0x6f, 0x73, 0x65, 0x45, 0x6e, 0x76, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x1a, 0x0a, 0x08, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x61, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x08, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x61, 0x67, 0x22, 0xd6, 0x03, 0x0a, 0x0f,
-	0x4c, 0x69, 0x73, 0x74, 0x45, 0x6e, 0x76, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x43, 0x0a, 0x09, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x61, 0x67, 0x73, 0x18, 0x01, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x25, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x45,
-	0x6e, 0x76, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74,
-	0x54, 0x61, 0x67, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x09, 0x65, 0x76, 0x65, 0x6e, 0x74,
-	0x54, 0x61, 0x67, 0x73, 0x12, 0x5b, 0x0a, 0x11, 0x73, 0x74, 0x61, 0x72, 0x74, 0x69, 0x6e, 0x67,
-	0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x61, 0x67, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x2d, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x6e, 0x76, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x53, 0x74, 0x61, 0x72, 0x74, 0x69, 0x6e, 0x67,
-	0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x61, 0x67, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x11,
-	0x73, 0x74, 0x61, 0x72, 0x74, 0x69, 0x6e, 0x67, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x61, 0x67,
-	0x73, 0x12, 0x58, 0x0a, 0x10, 0x63, 0x6c, 0x6f, 0x73, 0x69, 0x6e, 0x67, 0x45, 0x76, 0x65, 0x6e,
-	0x74, 0x54, 0x61, 0x67, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x61, 0x67,
-	0x65, 0x6e, 0x74, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x6e, 0x76, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x2e, 0x43, 0x6c, 0x6f, 0x73, 0x69, 0x6e, 0x67, 0x45, 0x76, 0x65, 0x6e, 0x74,
-	0x54, 0x61, 0x67, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x10, 0x63, 0x6c, 0x6f, 0x73, 0x69,
-	0x6e, 0x67, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x61, 0x67, 0x73, 0x1a, 0x3c, 0x0a, 0x0e, 0x45,
-	0x76, 0x65, 0x6e, 0x74, 0x54, 0x61, 0x67, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a,
-	0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12,
-	0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05,
-	0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x44, 0x0a, 0x16, 0x53, 0x74, 0x61,
-	0x72, 0x74, 0x69, 0x6e, 0x67, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x61, 0x67, 0x73, 0x45, 0x6e,
-	0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a,
-	0x43, 0x0a, 0x15, 0x43, 0x6c, 0x6f, 0x73, 0x69, 0x6e, 0x67, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54,
-	0x61, 0x67, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61,
-	0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
-	0x3a, 0x02, 0x38, 0x01, 0x22, 0x44, 0x0a, 0x10, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4c, 0x61,
-	0x62, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x65, 0x76, 0x65, 0x6e,
-	0x74, 0x54, 0x61, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x65, 0x76, 0x65, 0x6e,
-	0x74, 0x54, 0x61, 0x67, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x73, 0x56, 0x50, 0x4e, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x08, 0x52, 0x05, 0x69, 0x73, 0x56, 0x50, 0x4e, 0x22, 0x2e, 0x0a, 0x14, 0x43, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x56, 0x70, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x6c, 0x61, 0x62, 0x54, 0x61, 0x67, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x06, 0x6c, 0x61, 0x62, 0x54, 0x61, 0x67, 0x22, 0x31, 0x0a, 0x15, 0x43, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x56, 0x70, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x18, 0x01,
-	0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x22, 0x29, 0x0a,
-	0x0f, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x4c, 0x61, 0x62, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x16, 0x0a, 0x06, 0x6c, 0x61, 0x62, 0x54, 0x61, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x06, 0x6c, 0x61, 0x62, 0x54, 0x61, 0x67, 0x22, 0xbc, 0x01, 0x0a, 0x0f, 0x45, 0x78, 0x65,
-	0x72, 0x63, 0x69, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06,
-	0x6c, 0x61, 0x62, 0x54, 0x61, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6c, 0x61,
-	0x62, 0x54, 0x61, 0x67, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x6e, 0x76, 0x54, 0x61, 0x67, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x65, 0x6e, 0x76, 0x54, 0x61, 0x67, 0x12, 0x1c, 0x0a, 0x09,
-	0x65, 0x78, 0x65, 0x72, 0x63, 0x69, 0x73, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52,
-	0x09, 0x65, 0x78, 0x65, 0x72, 0x63, 0x69, 0x73, 0x65, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x65, 0x78,
-	0x65, 0x72, 0x63, 0x69, 0x73, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x65, 0x78,
-	0x65, 0x72, 0x63, 0x69, 0x73, 0x65, 0x12, 0x3f, 0x0a, 0x0f, 0x65, 0x78, 0x65, 0x72, 0x63, 0x69,
-	0x73, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x15, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x45, 0x78, 0x65, 0x72, 0x63, 0x69, 0x73, 0x65,
-	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x0f, 0x65, 0x78, 0x65, 0x72, 0x63, 0x69, 0x73, 0x65,
-	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x22, 0x4e, 0x0a, 0x08, 0x56, 0x6d, 0x43, 0x6f, 0x6e,
-	0x66, 0x69, 0x67, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x05, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x6d, 0x65, 0x6d,
-	0x6f, 0x72, 0x79, 0x4d, 0x42, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x6d, 0x65, 0x6d,
-	0x6f, 0x72, 0x79, 0x4d, 0x42, 0x12, 0x10, 0x0a, 0x03, 0x63, 0x70, 0x75, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x01, 0x52, 0x03, 0x63, 0x70, 0x75, 0x22, 0x2a, 0x0a, 0x0e, 0x53, 0x74, 0x61, 0x74, 0x75,
-	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73,
-	0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73,
-	0x61, 0x67, 0x65, 0x22, 0xc4, 0x01, 0x0a, 0x03, 0x4c, 0x61, 0x62, 0x12, 0x10, 0x0a, 0x03, 0x74,
-	0x61, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x74, 0x61, 0x67, 0x12, 0x1a, 0x0a,
-	0x08, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x61, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x08, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x61, 0x67, 0x12, 0x2d, 0x0a, 0x09, 0x65, 0x78, 0x65,
-	0x72, 0x63, 0x69, 0x73, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x61,
-	0x67, 0x65, 0x6e, 0x74, 0x2e, 0x45, 0x78, 0x65, 0x72, 0x63, 0x69, 0x73, 0x65, 0x52, 0x09, 0x65,
-	0x78, 0x65, 0x72, 0x63, 0x69, 0x73, 0x65, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x73, 0x56, 0x50,
-	0x4e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x69, 0x73, 0x56, 0x50, 0x4e, 0x12, 0x2e,
-	0x0a, 0x09, 0x67, 0x75, 0x61, 0x63, 0x43, 0x72, 0x65, 0x64, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x10, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x47, 0x75, 0x61, 0x63, 0x43, 0x72,
-	0x65, 0x64, 0x73, 0x52, 0x09, 0x67, 0x75, 0x61, 0x63, 0x43, 0x72, 0x65, 0x64, 0x73, 0x12, 0x1a,
-	0x0a, 0x08, 0x76, 0x70, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x09,
-	0x52, 0x08, 0x76, 0x70, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x73, 0x22, 0x86, 0x01, 0x0a, 0x08, 0x45,
-	0x78, 0x65, 0x72, 0x63, 0x69, 0x73, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x74, 0x61, 0x67, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x74, 0x61, 0x67, 0x12, 0x3c, 0x0a, 0x0e, 0x63, 0x68, 0x69,
-	0x6c, 0x64, 0x45, 0x78, 0x65, 0x72, 0x63, 0x69, 0x73, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x14, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x43, 0x68, 0x69, 0x6c, 0x64, 0x45,
-	0x78, 0x65, 0x72, 0x63, 0x69, 0x73, 0x65, 0x52, 0x0e, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x45, 0x78,
-	0x65, 0x72, 0x63, 0x69, 0x73, 0x65, 0x73, 0x12, 0x2a, 0x0a, 0x08, 0x6d, 0x61, 0x63, 0x68, 0x69,
-	0x6e, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x61, 0x67, 0x65, 0x6e,
-	0x74, 0x2e, 0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x52, 0x08, 0x6d, 0x61, 0x63, 0x68, 0x69,
-	0x6e, 0x65, 0x73, 0x22, 0x35, 0x0a, 0x0d, 0x43, 0x68, 0x69, 0x6c, 0x64, 0x45, 0x78, 0x65, 0x72,
-	0x63, 0x69, 0x73, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x74, 0x61, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x03, 0x74, 0x61, 0x67, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x6c, 0x61, 0x67, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x66, 0x6c, 0x61, 0x67, 0x22, 0x73, 0x0a, 0x07, 0x4d, 0x61,
-	0x63, 0x68, 0x69, 0x6e, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x14, 0x0a,
-	0x05, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x69, 0x6d,
-	0x61, 0x67, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x18, 0x04, 0x20,
-	0x03, 0x28, 0x09, 0x52, 0x06, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x74,
-	0x79, 0x70, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x22,
-	0x43, 0x0a, 0x09, 0x47, 0x75, 0x61, 0x63, 0x43, 0x72, 0x65, 0x64, 0x73, 0x12, 0x1a, 0x0a, 0x08,
-	0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
-	0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73,
-	0x77, 0x6f, 0x72, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73,
-	0x77, 0x6f, 0x72, 0x64, 0x22, 0x75, 0x0a, 0x0e, 0x45, 0x78, 0x65, 0x72, 0x63, 0x69, 0x73, 0x65,
-	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x10, 0x0a, 0x03, 0x74, 0x61, 0x67, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x03, 0x74, 0x61, 0x67, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74,
-	0x69, 0x63, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x69, 0x63,
-	0x12, 0x39, 0x0a, 0x08, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x03, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x45, 0x78, 0x65, 0x72, 0x63,
-	0x69, 0x73, 0x65, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69,
-	0x67, 0x52, 0x08, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x22, 0xe7, 0x01, 0x0a, 0x16,
-	0x45, 0x78, 0x65, 0x72, 0x63, 0x69, 0x73, 0x65, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65,
-	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x12, 0x16, 0x0a, 0x06,
-	0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x6d, 0x65,
-	0x6d, 0x6f, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x63, 0x70, 0x75, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x02, 0x52, 0x03, 0x63, 0x70, 0x75, 0x12, 0x27, 0x0a, 0x04, 0x65, 0x6e, 0x76, 0x73, 0x18, 0x04,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x45, 0x6e, 0x76,
-	0x56, 0x61, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x04, 0x65, 0x6e, 0x76, 0x73, 0x12,
-	0x35, 0x0a, 0x08, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x72, 0x65, 0x6e, 0x18, 0x05, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x19, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x43, 0x68, 0x69, 0x6c, 0x64, 0x72,
-	0x65, 0x6e, 0x43, 0x68, 0x61, 0x6c, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x08, 0x63, 0x68,
-	0x69, 0x6c, 0x64, 0x72, 0x65, 0x6e, 0x12, 0x2d, 0x0a, 0x07, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64,
-	0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e,
-	0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x07, 0x72, 0x65,
-	0x63, 0x6f, 0x72, 0x64, 0x73, 0x22, 0x38, 0x0a, 0x0c, 0x45, 0x6e, 0x76, 0x56, 0x61, 0x72, 0x43,
-	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c,
-	0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22,
-	0x6c, 0x0a, 0x12, 0x43, 0x68, 0x69, 0x6c, 0x64, 0x72, 0x65, 0x6e, 0x43, 0x68, 0x61, 0x6c, 0x43,
-	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x10, 0x0a, 0x03, 0x74, 0x61, 0x67, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x03, 0x74, 0x61, 0x67, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x65,
-	0x6e, 0x76, 0x46, 0x6c, 0x61, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x65, 0x6e,
-	0x76, 0x46, 0x6c, 0x61, 0x67, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x69, 0x63, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x69, 0x63, 0x22, 0x4a, 0x0a,
-	0x0c, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x12, 0x0a,
-	0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70,
-	0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x32, 0xef, 0x08, 0x0a, 0x05, 0x41, 0x67,
-	0x65, 0x6e, 0x74, 0x12, 0x44, 0x0a, 0x11, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x45, 0x6e, 0x76,
-	0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x16, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74,
-	0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x45, 0x6e, 0x76, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x15, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x43, 0x0a, 0x10, 0x43, 0x6c, 0x6f,
-	0x73, 0x65, 0x45, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x16, 0x2e,
-	0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x45, 0x6e, 0x76, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x53, 0x74,
-	0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3a,
-	0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e,
-	0x74, 0x73, 0x12, 0x0c, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79,
-	0x1a, 0x16, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x6e, 0x76,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x43, 0x0a, 0x0f, 0x43, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x4c, 0x61, 0x62, 0x46, 0x6f, 0x72, 0x45, 0x6e, 0x76, 0x12, 0x17, 0x2e,
-	0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4c, 0x61, 0x62, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x53,
-	0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
-	0x52, 0x0a, 0x13, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x56, 0x70, 0x6e, 0x43, 0x6f, 0x6e, 0x66,
-	0x46, 0x6f, 0x72, 0x4c, 0x61, 0x62, 0x12, 0x1b, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x43,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x56, 0x70, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x43, 0x72, 0x65, 0x61,
-	0x74, 0x65, 0x56, 0x70, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x00, 0x12, 0x3b, 0x0a, 0x08, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x4c, 0x61, 0x62, 0x12,
-	0x16, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x4c, 0x61, 0x62,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e,
-	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
-	0x12, 0x44, 0x0a, 0x11, 0x41, 0x64, 0x64, 0x45, 0x78, 0x65, 0x72, 0x63, 0x69, 0x73, 0x65, 0x73,
-	0x54, 0x6f, 0x45, 0x6e, 0x76, 0x12, 0x16, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x45, 0x78,
-	0x65, 0x72, 0x63, 0x69, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e,
-	0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x44, 0x0a, 0x11, 0x41, 0x64, 0x64, 0x45, 0x78, 0x65,
-	0x72, 0x63, 0x69, 0x73, 0x65, 0x73, 0x54, 0x6f, 0x4c, 0x61, 0x62, 0x12, 0x16, 0x2e, 0x61, 0x67,
-	0x65, 0x6e, 0x74, 0x2e, 0x45, 0x78, 0x65, 0x72, 0x63, 0x69, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x53, 0x74, 0x61, 0x74,
-	0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3b, 0x0a, 0x08,
-	0x52, 0x65, 0x73, 0x65, 0x74, 0x4c, 0x61, 0x62, 0x12, 0x16, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74,
-	0x2e, 0x52, 0x65, 0x73, 0x65, 0x74, 0x4c, 0x61, 0x62, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x15, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x45, 0x0a, 0x12, 0x52, 0x65, 0x73,
-	0x65, 0x74, 0x45, 0x78, 0x65, 0x72, 0x63, 0x69, 0x73, 0x65, 0x49, 0x6e, 0x4c, 0x61, 0x62, 0x12,
-	0x16, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x45, 0x78, 0x65, 0x72, 0x63, 0x69, 0x73, 0x65,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e,
-	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
-	0x12, 0x45, 0x0a, 0x12, 0x53, 0x74, 0x61, 0x72, 0x74, 0x45, 0x78, 0x65, 0x72, 0x63, 0x69, 0x73,
-	0x65, 0x49, 0x6e, 0x4c, 0x61, 0x62, 0x12, 0x16, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x45,
-	0x78, 0x65, 0x72, 0x63, 0x69, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15,
-	0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x44, 0x0a, 0x11, 0x53, 0x74, 0x6f, 0x70, 0x45,
-	0x78, 0x65, 0x72, 0x63, 0x69, 0x73, 0x65, 0x49, 0x6e, 0x4c, 0x61, 0x62, 0x12, 0x16, 0x2e, 0x61,
-	0x67, 0x65, 0x6e, 0x74, 0x2e, 0x45, 0x78, 0x65, 0x72, 0x63, 0x69, 0x73, 0x65, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x53, 0x74, 0x61,
-	0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x31, 0x0a,
-	0x04, 0x50, 0x69, 0x6e, 0x67, 0x12, 0x12, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x50, 0x69,
-	0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x61, 0x67, 0x65, 0x6e,
-	0x74, 0x2e, 0x50, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
-	0x12, 0x41, 0x0a, 0x0d, 0x4d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x53, 0x74, 0x72, 0x65, 0x61,
-	0x6d, 0x12, 0x12, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x50, 0x69, 0x6e, 0x67, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x4d, 0x6f,
-	0x6e, 0x69, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x28,
-	0x01, 0x30, 0x01, 0x12, 0x37, 0x0a, 0x06, 0x47, 0x65, 0x74, 0x4c, 0x61, 0x62, 0x12, 0x14, 0x2e,
-	0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x4c, 0x61, 0x62, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x4c,
-	0x61, 0x62, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x42, 0x0a, 0x0d,
-	0x47, 0x65, 0x74, 0x48, 0x6f, 0x73, 0x74, 0x73, 0x49, 0x6e, 0x4c, 0x61, 0x62, 0x12, 0x16, 0x2e,
-	0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x48, 0x6f, 0x73, 0x74, 0x73, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x47, 0x65,
-	0x74, 0x48, 0x6f, 0x73, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
-	0x12, 0x39, 0x0a, 0x0c, 0x52, 0x65, 0x73, 0x65, 0x74, 0x56, 0x6d, 0x49, 0x6e, 0x4c, 0x61, 0x62,
-	0x12, 0x10, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x56, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x15, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75,
-	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x42, 0x36, 0x5a, 0x34, 0x67,
-	0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x61, 0x61, 0x75, 0x2d, 0x6e, 0x65,
-	0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2d, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x2f, 0x68,
-	0x61, 0x61, 0x75, 0x6b, 0x69, 0x6e, 0x73, 0x2d, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2f, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
-}
-
-var (
-	file_agent_proto_rawDescOnce sync.Once
-	file_agent_proto_rawDescData = file_agent_proto_rawDesc
-)
-
-func file_agent_proto_rawDescGZIP() []byte {
-	file_agent_proto_rawDescOnce.Do(func() {
-		file_agent_proto_rawDescData = protoimpl.X.CompressGZIP(file_agent_proto_rawDescData)
-	})
-	return file_agent_proto_rawDescData
-}
-
-var file_agent_proto_msgTypes = make([]protoimpl.MessageInfo, 34)
-var file_agent_proto_goTypes = []interface{}{
-	(*Empty)(nil),                  // 0: agent.Empty
-	(*VmRequest)(nil),              // 1: agent.VmRequest
-	(*ResetLabRequest)(nil),        // 2: agent.ResetLabRequest
-	(*GetLabRequest)(nil),          // 3: agent.GetLabRequest
-	(*GetLabResponse)(nil),         // 4: agent.GetLabResponse
-	(*GetHostsRequest)(nil),        // 5: agent.GetHostsRequest
-	(*GetHostsResponse)(nil),       // 6: agent.GetHostsResponse
-	(*MonitorResponse)(nil),        // 7: agent.MonitorResponse
-	(*Resources)(nil),              // 8: agent.Resources
-	(*PingRequest)(nil),            // 9: agent.PingRequest
-	(*PingResponse)(nil),           // 10: agent.PingResponse
-	(*CreatEnvRequest)(nil),        // 11: agent.CreatEnvRequest
-	(*CloseEnvRequest)(nil),        // 12: agent.CloseEnvRequest
-	(*ListEnvResponse)(nil),        // 13: agent.ListEnvResponse
-	(*CreateLabRequest)(nil),       // 14: agent.CreateLabRequest
-	(*CreateVpnConfRequest)(nil),   // 15: agent.CreateVpnConfRequest
-	(*CreateVpnConfResponse)(nil),  // 16: agent.CreateVpnConfResponse
-	(*CloseLabRequest)(nil),        // 17: agent.CloseLabRequest
-	(*ExerciseRequest)(nil),        // 18: agent.ExerciseRequest
-	(*VmConfig)(nil),               // 19: agent.VmConfig
-	(*StatusResponse)(nil),         // 20: agent.StatusResponse
-	(*Lab)(nil),                    // 21: agent.Lab
-	(*Exercise)(nil),               // 22: agent.Exercise
-	(*ChildExercise)(nil),          // 23: agent.ChildExercise
-	(*Machine)(nil),                // 24: agent.Machine
-	(*GuacCreds)(nil),              // 25: agent.GuacCreds
-	(*ExerciseConfig)(nil),         // 26: agent.ExerciseConfig
-	(*ExerciseInstanceConfig)(nil), // 27: agent.ExerciseInstanceConfig
-	(*EnvVarConfig)(nil),           // 28: agent.EnvVarConfig
-	(*ChildrenChalConfig)(nil),     // 29: agent.ChildrenChalConfig
-	(*RecordConfig)(nil),           // 30: agent.RecordConfig
-	nil,                            // 31: agent.ListEnvResponse.EventTagsEntry
-	nil,                            // 32: agent.ListEnvResponse.StartingEventTagsEntry
-	nil,                            // 33: agent.ListEnvResponse.ClosingEventTagsEntry
-}
-var file_agent_proto_depIdxs = []int32{
-	21, // 0: agent.GetLabResponse.lab:type_name -> agent.Lab
-	21, // 1: agent.MonitorResponse.newLabs:type_name -> agent.Lab
-	8,  // 2: agent.MonitorResponse.resources:type_name -> agent.Resources
-	19, // 3: agent.CreatEnvRequest.vm:type_name -> agent.VmConfig
-	26, // 4: agent.CreatEnvRequest.exerciseConfigs:type_name -> agent.ExerciseConfig
-	31, // 5: agent.ListEnvResponse.eventTags:type_name -> agent.ListEnvResponse.EventTagsEntry
-	32, // 6: agent.ListEnvResponse.startingEventTags:type_name -> agent.ListEnvResponse.StartingEventTagsEntry
-	33, // 7: agent.ListEnvResponse.closingEventTags:type_name -> agent.ListEnvResponse.ClosingEventTagsEntry
-	26, // 8: agent.ExerciseRequest.exerciseConfigs:type_name -> agent.ExerciseConfig
-	22, // 9: agent.Lab.exercises:type_name -> agent.Exercise
-	25, // 10: agent.Lab.guacCreds:type_name -> agent.GuacCreds
-	23, // 11: agent.Exercise.childExercises:type_name -> agent.ChildExercise
-	24, // 12: agent.Exercise.machines:type_name -> agent.Machine
-	27, // 13: agent.ExerciseConfig.instance:type_name -> agent.ExerciseInstanceConfig
-	28, // 14: agent.ExerciseInstanceConfig.envs:type_name -> agent.EnvVarConfig
-	29, // 15: agent.ExerciseInstanceConfig.children:type_name -> agent.ChildrenChalConfig
-	30, // 16: agent.ExerciseInstanceConfig.records:type_name -> agent.RecordConfig
-	11, // 17: agent.Agent.CreateEnvironment:input_type -> agent.CreatEnvRequest
-	12, // 18: agent.Agent.CloseEnvironment:input_type -> agent.CloseEnvRequest
-	0,  // 19: agent.Agent.ListEnvironments:input_type -> agent.Empty
-	14, // 20: agent.Agent.CreateLabForEnv:input_type -> agent.CreateLabRequest
-	15, // 21: agent.Agent.CreateVpnConfForLab:input_type -> agent.CreateVpnConfRequest
-	17, // 22: agent.Agent.CloseLab:input_type -> agent.CloseLabRequest
-	18, // 23: agent.Agent.AddExercisesToEnv:input_type -> agent.ExerciseRequest
-	18, // 24: agent.Agent.AddExercisesToLab:input_type -> agent.ExerciseRequest
-	2,  // 25: agent.Agent.ResetLab:input_type -> agent.ResetLabRequest
-	18, // 26: agent.Agent.ResetExerciseInLab:input_type -> agent.ExerciseRequest
-	18, // 27: agent.Agent.StartExerciseInLab:input_type -> agent.ExerciseRequest
-	18, // 28: agent.Agent.StopExerciseInLab:input_type -> agent.ExerciseRequest
-	9,  // 29: agent.Agent.Ping:input_type -> agent.PingRequest
-	9,  // 30: agent.Agent.MonitorStream:input_type -> agent.PingRequest
-	3,  // 31: agent.Agent.GetLab:input_type -> agent.GetLabRequest
-	5,  // 32: agent.Agent.GetHostsInLab:input_type -> agent.GetHostsRequest
-	1,  // 33: agent.Agent.ResetVmInLab:input_type -> agent.VmRequest
-	20, // 34: agent.Agent.CreateEnvironment:output_type -> agent.StatusResponse
-	20, // 35: agent.Agent.CloseEnvironment:output_type -> agent.StatusResponse
-	13, // 36: agent.Agent.ListEnvironments:output_type -> agent.ListEnvResponse
-	20, // 37: agent.Agent.CreateLabForEnv:output_type -> agent.StatusResponse
-	16, // 38: agent.Agent.CreateVpnConfForLab:output_type -> agent.CreateVpnConfResponse
-	20, // 39: agent.Agent.CloseLab:output_type -> agent.StatusResponse
-	20, // 40: agent.Agent.AddExercisesToEnv:output_type -> agent.StatusResponse
-	20, // 41: agent.Agent.AddExercisesToLab:output_type -> agent.StatusResponse
-	20, // 42: agent.Agent.ResetLab:output_type -> agent.StatusResponse
-	20, // 43: agent.Agent.ResetExerciseInLab:output_type -> agent.StatusResponse
-	20, // 44: agent.Agent.StartExerciseInLab:output_type -> agent.StatusResponse
-	20, // 45: agent.Agent.StopExerciseInLab:output_type -> agent.StatusResponse
-	10, // 46: agent.Agent.Ping:output_type -> agent.PingResponse
-	7,  // 47: agent.Agent.MonitorStream:output_type -> agent.MonitorResponse
-	4,  // 48: agent.Agent.GetLab:output_type -> agent.GetLabResponse
-	6,  // 49: agent.Agent.GetHostsInLab:output_type -> agent.GetHostsResponse
-	20, // 50: agent.Agent.ResetVmInLab:output_type -> agent.StatusResponse
-	34, // [34:51] is the sub-list for method output_type
-	17, // [17:34] is the sub-list for method input_type
-	17, // [17:17] is the sub-list for extension type_name
-	17, // [17:17] is the sub-list for extension extendee
-	0,  // [0:17] is the sub-list for field type_name
-}
-
-func init() { file_agent_proto_init() }
-func file_agent_proto_init() {
-	if File_agent_proto != nil {
-		return
-	}
-	if !protoimpl.UnsafeEnabled {
-		file_agent_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Empty); i {
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecordConfig.ProtoReflect.Descriptor instead.
+func (*RecordConfig) Descriptor() ([]byte, []int) {
+	return file_agent_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *RecordConfig) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *RecordConfig) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *RecordConfig) GetData() string {
+	if x != nil {
+		return x.Data
+	}
+	return ""
+}
+
+var File_agent_proto protoreflect.FileDescriptor
+
+var file_agent_proto_rawDesc = []byte{
+	0x0a, 0x0b, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x05, 0x61,
+	0x67, 0x65, 0x6e, 0x74, 0x22, 0x07, 0x0a, 0x05, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x7f, 0x0a,
+	0x09, 0x56, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x6c, 0x61,
+	0x62, 0x54, 0x61, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6c, 0x61, 0x62, 0x54,
+	0x61, 0x67, 0x12, 0x36, 0x0a, 0x14, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x02, 0x18, 0x01, 0x52, 0x14, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x12, 0x22, 0x0a, 0x0c, 0x67, 0x75,
+	0x61, 0x63, 0x55, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0c, 0x67, 0x75, 0x61, 0x63, 0x55, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x5a,
+	0x0a, 0x10, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x61, 0x67, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x61, 0x67, 0x12, 0x16,
+	0x0a, 0x06, 0x6c, 0x61, 0x62, 0x54, 0x61, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x6c, 0x61, 0x62, 0x54, 0x61, 0x67, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x65, 0x0a, 0x09, 0x52, 0x65,
+	0x63, 0x6f, 0x72, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x16, 0x0a, 0x06, 0x6c, 0x61, 0x62, 0x54, 0x61,
+	0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6c, 0x61, 0x62, 0x54, 0x61, 0x67, 0x12,
+	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x6f, 0x64, 0x54, 0x69,
+	0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x6d, 0x6f, 0x64, 0x54, 0x69, 0x6d,
+	0x65, 0x22, 0x4a, 0x0a, 0x16, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x69,
+	0x6e, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x30, 0x0a, 0x0a, 0x72,
+	0x65, 0x63, 0x6f, 0x72, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x10, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x69, 0x6e,
+	0x67, 0x52, 0x0a, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x22, 0x24, 0x0a,
+	0x0e, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x69, 0x6e, 0x67, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x12,
+	0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64,
+	0x61, 0x74, 0x61, 0x22, 0x4a, 0x0a, 0x18, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x52, 0x65,
+	0x63, 0x6f, 0x72, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x2e, 0x0a, 0x09, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x69, 0x6e, 0x67, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x10, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x72,
+	0x64, 0x69, 0x6e, 0x67, 0x52, 0x09, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x69, 0x6e, 0x67, 0x22,
+	0x50, 0x0a, 0x10, 0x53, 0x70, 0x65, 0x63, 0x74, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x6c, 0x61, 0x62, 0x54, 0x61, 0x67, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x6c, 0x61, 0x62, 0x54, 0x61, 0x67, 0x12, 0x24, 0x0a, 0x0d, 0x65,
+	0x78, 0x70, 0x69, 0x72, 0x79, 0x4d, 0x69, 0x6e, 0x75, 0x74, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x0d, 0x65, 0x78, 0x70, 0x69, 0x72, 0x79, 0x4d, 0x69, 0x6e, 0x75, 0x74, 0x65,
+	0x73, 0x22, 0x4d, 0x0a, 0x11, 0x53, 0x70, 0x65, 0x63, 0x74, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x55,
+	0x72, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x55,
+	0x72, 0x6c, 0x12, 0x1c, 0x0a, 0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74,
+	0x22, 0x4f, 0x0a, 0x0f, 0x54, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x61, 0x6c, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x6c, 0x61, 0x62, 0x54, 0x61, 0x67, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x6c, 0x61, 0x62, 0x54, 0x61, 0x67, 0x12, 0x24, 0x0a, 0x0d, 0x65,
+	0x78, 0x70, 0x69, 0x72, 0x79, 0x4d, 0x69, 0x6e, 0x75, 0x74, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x0d, 0x65, 0x78, 0x70, 0x69, 0x72, 0x79, 0x4d, 0x69, 0x6e, 0x75, 0x74, 0x65,
+	0x73, 0x22, 0x52, 0x0a, 0x10, 0x54, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x61, 0x6c, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x74, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x61,
+	0x6c, 0x55, 0x72, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x74, 0x65, 0x72, 0x6d,
+	0x69, 0x6e, 0x61, 0x6c, 0x55, 0x72, 0x6c, 0x12, 0x1c, 0x0a, 0x09, 0x65, 0x78, 0x70, 0x69, 0x72,
+	0x65, 0x73, 0x41, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x65, 0x78, 0x70, 0x69,
+	0x72, 0x65, 0x73, 0x41, 0x74, 0x22, 0x4a, 0x0a, 0x12, 0x53, 0x65, 0x74, 0x4c, 0x6f, 0x67, 0x4c,
+	0x65, 0x76, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x6c,
+	0x65, 0x76, 0x65, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6c, 0x65, 0x76, 0x65,
+	0x6c, 0x12, 0x1e, 0x0a, 0x0a, 0x72, 0x65, 0x73, 0x65, 0x74, 0x41, 0x66, 0x74, 0x65, 0x72, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x72, 0x65, 0x73, 0x65, 0x74, 0x41, 0x66, 0x74, 0x65,
+	0x72, 0x22, 0x51, 0x0a, 0x13, 0x53, 0x65, 0x74, 0x4c, 0x6f, 0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x24, 0x0a, 0x0d, 0x70, 0x72, 0x65, 0x76,
+	0x69, 0x6f, 0x75, 0x73, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0d, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x14,
+	0x0a, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6c,
+	0x65, 0x76, 0x65, 0x6c, 0x22, 0x2e, 0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x61, 0x73, 0x6b,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x65, 0x76, 0x65, 0x6e,
+	0x74, 0x54, 0x61, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x65, 0x76, 0x65, 0x6e,
+	0x74, 0x54, 0x61, 0x67, 0x22, 0x36, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x61, 0x73, 0x6b,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x21, 0x0a, 0x05, 0x74, 0x61, 0x73,
+	0x6b, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74,
+	0x2e, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x05, 0x74, 0x61, 0x73, 0x6b, 0x73, 0x22, 0x9e, 0x02, 0x0a,
+	0x04, 0x54, 0x61, 0x73, 0x6b, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x65, 0x76, 0x65,
+	0x6e, 0x74, 0x54, 0x61, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x65, 0x76, 0x65,
+	0x6e, 0x74, 0x54, 0x61, 0x67, 0x12, 0x16, 0x0a, 0x06, 0x6c, 0x61, 0x62, 0x54, 0x61, 0x67, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6c, 0x61, 0x62, 0x54, 0x61, 0x67, 0x12, 0x1a, 0x0a,
+	0x08, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61,
+	0x74, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12,
+	0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64,
+	0x41, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x64, 0x41, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x41, 0x74,
+	0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x41,
+	0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x66, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x41, 0x74, 0x18,
+	0x0a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x66, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x41,
+	0x74, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x18, 0x0b, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x08, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x22, 0xb0, 0x03,
+	0x0a, 0x11, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x20, 0x0a,
+	0x0b, 0x63, 0x6f, 0x6d, 0x70, 0x69, 0x6c, 0x65, 0x44, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0b, 0x63, 0x6f, 0x6d, 0x70, 0x69, 0x6c, 0x65, 0x44, 0x61, 0x74, 0x65, 0x12,
+	0x1c, 0x0a, 0x09, 0x67, 0x6f, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x67, 0x6f, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x0e, 0x0a,
+	0x02, 0x6f, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x6f, 0x73, 0x12, 0x12, 0x0a,
+	0x04, 0x61, 0x72, 0x63, 0x68, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x61, 0x72, 0x63,
+	0x68, 0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x61, 0x62, 0x54, 0x79, 0x70, 0x65, 0x73, 0x18, 0x06, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x08, 0x6c, 0x61, 0x62, 0x54, 0x79, 0x70, 0x65, 0x73, 0x12, 0x2a, 0x0a,
+	0x10, 0x66, 0x72, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x64, 0x42, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64,
+	0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x09, 0x52, 0x10, 0x66, 0x72, 0x6f, 0x6e, 0x74, 0x65, 0x6e,
+	0x64, 0x42, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x76, 0x70, 0x6e,
+	0x42, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b,
+	0x76, 0x70, 0x6e, 0x42, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x6f,
+	0x76, 0x61, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x6f, 0x76, 0x61, 0x73, 0x12,
+	0x1e, 0x0a, 0x0a, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x0a, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x0a, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x69, 0x65, 0x73, 0x12,
+	0x42, 0x0a, 0x08, 0x66, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x18, 0x0b, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x26, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x49,
+	0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x46, 0x65, 0x61, 0x74,
+	0x75, 0x72, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x08, 0x66, 0x65, 0x61, 0x74, 0x75,
+	0x72, 0x65, 0x73, 0x1a, 0x3b, 0x0a, 0x0d, 0x46, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01,
+	0x22, 0x5d, 0x0a, 0x0f, 0x41, 0x75, 0x64, 0x69, 0x74, 0x4c, 0x6f, 0x67, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12, 0x0e, 0x0a, 0x02, 0x74, 0x6f, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x02, 0x74, 0x6f, 0x12, 0x10, 0x0a, 0x03, 0x74, 0x61, 0x67, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x74, 0x61, 0x67, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d,
+	0x69, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x22,
+	0xe6, 0x01, 0x0a, 0x0a, 0x41, 0x75, 0x64, 0x69, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x12,
+	0x0a, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x74, 0x69,
+	0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x61, 0x6c, 0x6c, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x63, 0x61, 0x6c, 0x6c, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x65,
+	0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x65, 0x65, 0x72, 0x12, 0x16,
+	0x0a, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x54,
+	0x61, 0x67, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x54,
+	0x61, 0x67, 0x12, 0x16, 0x0a, 0x06, 0x6c, 0x61, 0x62, 0x54, 0x61, 0x67, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x6c, 0x61, 0x62, 0x54, 0x61, 0x67, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x1e, 0x0a, 0x0a, 0x64, 0x75, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x64, 0x75,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x73, 0x22, 0x3f, 0x0a, 0x10, 0x41, 0x75, 0x64, 0x69,
+	0x74, 0x4c, 0x6f, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2b, 0x0a, 0x07,
+	0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e,
+	0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x41, 0x75, 0x64, 0x69, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x52, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x22, 0x29, 0x0a, 0x0f, 0x52, 0x65, 0x73,
+	0x65, 0x74, 0x4c, 0x61, 0x62, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06,
+	0x6c, 0x61, 0x62, 0x54, 0x61, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6c, 0x61,
+	0x62, 0x54, 0x61, 0x67, 0x22, 0x27, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x4c, 0x61, 0x62, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x6c, 0x61, 0x62, 0x54, 0x61, 0x67, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6c, 0x61, 0x62, 0x54, 0x61, 0x67, 0x22, 0x2e, 0x0a,
+	0x0e, 0x47, 0x65, 0x74, 0x4c, 0x61, 0x62, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x1c, 0x0a, 0x03, 0x6c, 0x61, 0x62, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x61,
+	0x67, 0x65, 0x6e, 0x74, 0x2e, 0x4c, 0x61, 0x62, 0x52, 0x03, 0x6c, 0x61, 0x62, 0x22, 0x29, 0x0a,
+	0x0f, 0x47, 0x65, 0x74, 0x48, 0x6f, 0x73, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x16, 0x0a, 0x06, 0x6c, 0x61, 0x62, 0x54, 0x61, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x6c, 0x61, 0x62, 0x54, 0x61, 0x67, 0x22, 0x28, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x48,
+	0x6f, 0x73, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05,
+	0x68, 0x6f, 0x73, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x68, 0x6f, 0x73,
+	0x74, 0x73, 0x22, 0xd8, 0x04, 0x0a, 0x0f, 0x4d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x68, 0x62, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x02, 0x68, 0x62, 0x12, 0x24, 0x0a, 0x07, 0x6e, 0x65, 0x77, 0x4c, 0x61, 0x62,
+	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e,
+	0x4c, 0x61, 0x62, 0x52, 0x07, 0x6e, 0x65, 0x77, 0x4c, 0x61, 0x62, 0x73, 0x12, 0x2e, 0x0a, 0x09,
+	0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x10, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x73, 0x52, 0x09, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x12, 0x20, 0x0a, 0x0b,
+	0x71, 0x75, 0x65, 0x75, 0x65, 0x64, 0x54, 0x61, 0x73, 0x6b, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x0b, 0x71, 0x75, 0x65, 0x75, 0x65, 0x64, 0x54, 0x61, 0x73, 0x6b, 0x73, 0x12, 0x2a,
+	0x0a, 0x10, 0x72, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x72, 0x65, 0x6a, 0x65, 0x63, 0x74,
+	0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x12, 0x5e, 0x0a, 0x12, 0x71, 0x75,
+	0x65, 0x75, 0x65, 0x64, 0x54, 0x61, 0x73, 0x6b, 0x73, 0x42, 0x79, 0x45, 0x76, 0x65, 0x6e, 0x74,
+	0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x4d,
+	0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x51,
+	0x75, 0x65, 0x75, 0x65, 0x64, 0x54, 0x61, 0x73, 0x6b, 0x73, 0x42, 0x79, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x12, 0x71, 0x75, 0x65, 0x75, 0x65, 0x64, 0x54, 0x61,
+	0x73, 0x6b, 0x73, 0x42, 0x79, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x49, 0x0a, 0x0b, 0x6c, 0x61,
+	0x62, 0x73, 0x42, 0x79, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x27, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x4d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x4c, 0x61, 0x62, 0x73, 0x42, 0x79, 0x45, 0x76,
+	0x65, 0x6e, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0b, 0x6c, 0x61, 0x62, 0x73, 0x42, 0x79,
+	0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x2b, 0x0a, 0x08, 0x6c, 0x61, 0x62, 0x55, 0x73, 0x61, 0x67,
+	0x65, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e,
+	0x4c, 0x61, 0x62, 0x55, 0x73, 0x61, 0x67, 0x65, 0x52, 0x08, 0x6c, 0x61, 0x62, 0x55, 0x73, 0x61,
+	0x67, 0x65, 0x12, 0x32, 0x0a, 0x08, 0x77, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x09,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x52, 0x65, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x57, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x52, 0x08, 0x77, 0x61,
+	0x72, 0x6e, 0x69, 0x6e, 0x67, 0x73, 0x1a, 0x45, 0x0a, 0x17, 0x51, 0x75, 0x65, 0x75, 0x65, 0x64,
+	0x54, 0x61, 0x73, 0x6b, 0x73, 0x42, 0x79, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x3e, 0x0a,
+	0x10, 0x4c, 0x61, 0x62, 0x73, 0x42, 0x79, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xcb, 0x03,
+	0x0a, 0x09, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x12, 0x22, 0x0a, 0x0c, 0x6d,
+	0x65, 0x6d, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x0c, 0x6d, 0x65, 0x6d, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x12,
+	0x10, 0x0a, 0x03, 0x63, 0x70, 0x75, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x03, 0x63, 0x70,
+	0x75, 0x12, 0x26, 0x0a, 0x0e, 0x6d, 0x65, 0x6d, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x55,
+	0x73, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0e, 0x6d, 0x65, 0x6d, 0x50, 0x65,
+	0x72, 0x63, 0x65, 0x6e, 0x74, 0x55, 0x73, 0x65, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x61, 0x62,
+	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x6c, 0x61, 0x62,
+	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x6d, 0x43, 0x6f, 0x75, 0x6e, 0x74,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x76, 0x6d, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12,
+	0x26, 0x0a, 0x0e, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x43, 0x6f, 0x75, 0x6e,
+	0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0e, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e,
+	0x65, 0x72, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x22, 0x0a, 0x0c, 0x6d, 0x65, 0x6d, 0x49, 0x6e,
+	0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x6d,
+	0x65, 0x6d, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x63,
+	0x70, 0x75, 0x43, 0x6f, 0x72, 0x65, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x63,
+	0x70, 0x75, 0x43, 0x6f, 0x72, 0x65, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x6f, 0x61, 0x64, 0x31,
+	0x18, 0x09, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x6c, 0x6f, 0x61, 0x64, 0x31, 0x12, 0x14, 0x0a,
+	0x05, 0x6c, 0x6f, 0x61, 0x64, 0x35, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x6c, 0x6f,
+	0x61, 0x64, 0x35, 0x12, 0x16, 0x0a, 0x06, 0x6c, 0x6f, 0x61, 0x64, 0x31, 0x35, 0x18, 0x0b, 0x20,
+	0x01, 0x28, 0x01, 0x52, 0x06, 0x6c, 0x6f, 0x61, 0x64, 0x31, 0x35, 0x12, 0x2a, 0x0a, 0x10, 0x6e,
+	0x65, 0x74, 0x52, 0x78, 0x42, 0x79, 0x74, 0x65, 0x73, 0x50, 0x65, 0x72, 0x53, 0x65, 0x63, 0x18,
+	0x0c, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x6e, 0x65, 0x74, 0x52, 0x78, 0x42, 0x79, 0x74, 0x65,
+	0x73, 0x50, 0x65, 0x72, 0x53, 0x65, 0x63, 0x12, 0x2a, 0x0a, 0x10, 0x6e, 0x65, 0x74, 0x54, 0x78,
+	0x42, 0x79, 0x74, 0x65, 0x73, 0x50, 0x65, 0x72, 0x53, 0x65, 0x63, 0x18, 0x0d, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x10, 0x6e, 0x65, 0x74, 0x54, 0x78, 0x42, 0x79, 0x74, 0x65, 0x73, 0x50, 0x65, 0x72,
+	0x53, 0x65, 0x63, 0x12, 0x26, 0x0a, 0x05, 0x64, 0x69, 0x73, 0x6b, 0x73, 0x18, 0x0e, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x10, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x44, 0x69, 0x73, 0x6b, 0x55,
+	0x73, 0x61, 0x67, 0x65, 0x52, 0x05, 0x64, 0x69, 0x73, 0x6b, 0x73, 0x22, 0x7f, 0x0a, 0x09, 0x44,
+	0x69, 0x73, 0x6b, 0x55, 0x73, 0x61, 0x67, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04,
+	0x70, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68,
+	0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x64, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x75, 0x73, 0x65, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x75, 0x73,
+	0x65, 0x64, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x0b, 0x75, 0x73, 0x65, 0x64, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x22, 0xe2, 0x02, 0x0a,
+	0x08, 0x4c, 0x61, 0x62, 0x55, 0x73, 0x61, 0x67, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x6c, 0x61, 0x62,
+	0x54, 0x61, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6c, 0x61, 0x62, 0x54, 0x61,
+	0x67, 0x12, 0x1a, 0x0a, 0x08, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x61, 0x67, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x61, 0x67, 0x12, 0x1e, 0x0a,
+	0x0a, 0x63, 0x70, 0x75, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x0a, 0x63, 0x70, 0x75, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x12, 0x20, 0x0a,
+	0x0b, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x42, 0x79, 0x74, 0x65, 0x73, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x0b, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12,
+	0x1e, 0x0a, 0x0a, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x73, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x0a, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x73, 0x12,
+	0x10, 0x0a, 0x03, 0x76, 0x6d, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03, 0x76, 0x6d,
+	0x73, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x64, 0x41, 0x74, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x73, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x64, 0x41, 0x74, 0x12,
+	0x1e, 0x0a, 0x0a, 0x6e, 0x65, 0x74, 0x52, 0x78, 0x42, 0x79, 0x74, 0x65, 0x73, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x0a, 0x6e, 0x65, 0x74, 0x52, 0x78, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12,
+	0x1e, 0x0a, 0x0a, 0x6e, 0x65, 0x74, 0x54, 0x78, 0x42, 0x79, 0x74, 0x65, 0x73, 0x18, 0x09, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x0a, 0x6e, 0x65, 0x74, 0x54, 0x78, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12,
+	0x26, 0x0a, 0x0e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x61, 0x64, 0x42, 0x79, 0x74, 0x65,
+	0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65,
+	0x61, 0x64, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x28, 0x0a, 0x0f, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
+	0x57, 0x72, 0x69, 0x74, 0x65, 0x42, 0x79, 0x74, 0x65, 0x73, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x0f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x57, 0x72, 0x69, 0x74, 0x65, 0x42, 0x79, 0x74, 0x65,
+	0x73, 0x22, 0x45, 0x0a, 0x0f, 0x4c, 0x61, 0x62, 0x55, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x61, 0x67,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x61, 0x67,
+	0x12, 0x16, 0x0a, 0x06, 0x6c, 0x61, 0x62, 0x54, 0x61, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x6c, 0x61, 0x62, 0x54, 0x61, 0x67, 0x22, 0x62, 0x0a, 0x10, 0x4c, 0x61, 0x62, 0x55,
+	0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x23, 0x0a, 0x04,
+	0x6c, 0x61, 0x62, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x61, 0x67, 0x65,
+	0x6e, 0x74, 0x2e, 0x4c, 0x61, 0x62, 0x55, 0x73, 0x61, 0x67, 0x65, 0x52, 0x04, 0x6c, 0x61, 0x62,
+	0x73, 0x12, 0x29, 0x0a, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x11, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x55,
+	0x73, 0x61, 0x67, 0x65, 0x52, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x22, 0xbc, 0x02, 0x0a,
+	0x0a, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x55, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x65,
+	0x76, 0x65, 0x6e, 0x74, 0x54, 0x61, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x65,
+	0x76, 0x65, 0x6e, 0x74, 0x54, 0x61, 0x67, 0x12, 0x12, 0x0a, 0x04, 0x6c, 0x61, 0x62, 0x73, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x6c, 0x61, 0x62, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x63,
+	0x70, 0x75, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x0a, 0x63, 0x70, 0x75, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x6d,
+	0x65, 0x6d, 0x6f, 0x72, 0x79, 0x42, 0x79, 0x74, 0x65, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x0b, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x1e, 0x0a,
+	0x0a, 0x6e, 0x65, 0x74, 0x52, 0x78, 0x42, 0x79, 0x74, 0x65, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x0a, 0x6e, 0x65, 0x74, 0x52, 0x78, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x1e, 0x0a,
+	0x0a, 0x6e, 0x65, 0x74, 0x54, 0x78, 0x42, 0x79, 0x74, 0x65, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x0a, 0x6e, 0x65, 0x74, 0x54, 0x78, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x26, 0x0a,
+	0x0e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x61, 0x64, 0x42, 0x79, 0x74, 0x65, 0x73, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x61, 0x64,
+	0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x28, 0x0a, 0x0f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x57, 0x72,
+	0x69, 0x74, 0x65, 0x42, 0x79, 0x74, 0x65, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f,
+	0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x57, 0x72, 0x69, 0x74, 0x65, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12,
+	0x2a, 0x0a, 0x06, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x12, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x55, 0x73, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x74,
+	0x61, 0x6c, 0x73, 0x52, 0x06, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x73, 0x22, 0x95, 0x02, 0x0a, 0x0b,
+	0x55, 0x73, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x63,
+	0x70, 0x75, 0x48, 0x6f, 0x75, 0x72, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x63,
+	0x70, 0x75, 0x48, 0x6f, 0x75, 0x72, 0x73, 0x12, 0x24, 0x0a, 0x0d, 0x6d, 0x65, 0x6d, 0x6f, 0x72,
+	0x79, 0x47, 0x62, 0x48, 0x6f, 0x75, 0x72, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0d,
+	0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x47, 0x62, 0x48, 0x6f, 0x75, 0x72, 0x73, 0x12, 0x1e, 0x0a,
+	0x0a, 0x6e, 0x65, 0x74, 0x52, 0x78, 0x42, 0x79, 0x74, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x0a, 0x6e, 0x65, 0x74, 0x52, 0x78, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x1e, 0x0a,
+	0x0a, 0x6e, 0x65, 0x74, 0x54, 0x78, 0x42, 0x79, 0x74, 0x65, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x0a, 0x6e, 0x65, 0x74, 0x54, 0x78, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x26, 0x0a,
+	0x0e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x61, 0x64, 0x42, 0x79, 0x74, 0x65, 0x73, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x61, 0x64,
+	0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x28, 0x0a, 0x0f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x57, 0x72,
+	0x69, 0x74, 0x65, 0x42, 0x79, 0x74, 0x65, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f,
+	0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x57, 0x72, 0x69, 0x74, 0x65, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12,
+	0x14, 0x0a, 0x05, 0x73, 0x69, 0x6e, 0x63, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05,
+	0x73, 0x69, 0x6e, 0x63, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64,
+	0x41, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x64, 0x41, 0x74, 0x22, 0x61, 0x0a, 0x0f, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x57,
+	0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x68, 0x72, 0x65,
+	0x73, 0x68, 0x6f, 0x6c, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x74, 0x68, 0x72,
+	0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x22, 0x21, 0x0a, 0x0b, 0x50, 0x69, 0x6e, 0x67, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x69, 0x6e, 0x67, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x69, 0x6e, 0x67, 0x22, 0x46, 0x0a, 0x0c, 0x50, 0x69, 0x6e,
+	0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x6f, 0x6e,
+	0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x6f, 0x6e, 0x67, 0x12, 0x22, 0x0a,
+	0x0c, 0x6d, 0x65, 0x6d, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x0c, 0x6d, 0x65, 0x6d, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65,
+	0x64, 0x22, 0xe9, 0x02, 0x0a, 0x0f, 0x43, 0x72, 0x65, 0x61, 0x74, 0x45, 0x6e, 0x76, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x61,
+	0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x61,
+	0x67, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e, 0x76, 0x54, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x07, 0x65, 0x6e, 0x76, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1f, 0x0a, 0x02, 0x76,
+	0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e,
+	0x56, 0x6d, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x02, 0x76, 0x6d, 0x12, 0x20, 0x0a, 0x0b,
+	0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x4c, 0x61, 0x62, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x0b, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x4c, 0x61, 0x62, 0x73, 0x12, 0x1c,
+	0x0a, 0x09, 0x65, 0x78, 0x65, 0x72, 0x63, 0x69, 0x73, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x09, 0x65, 0x78, 0x65, 0x72, 0x63, 0x69, 0x73, 0x65, 0x73, 0x12, 0x1a, 0x0a, 0x08,
+	0x74, 0x65, 0x61, 0x6d, 0x53, 0x69, 0x7a, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08,
+	0x74, 0x65, 0x61, 0x6d, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x3f, 0x0a, 0x0f, 0x65, 0x78, 0x65, 0x72,
+	0x63, 0x69, 0x73, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x15, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x45, 0x78, 0x65, 0x72, 0x63, 0x69,
+	0x73, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x0f, 0x65, 0x78, 0x65, 0x72, 0x63, 0x69,
+	0x73, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x12, 0x26, 0x0a, 0x0e, 0x72, 0x65, 0x63,
+	0x6f, 0x72, 0x64, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x0e, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x73, 0x12, 0x3a, 0x0a, 0x0d, 0x64, 0x65, 0x73, 0x6b, 0x74, 0x6f, 0x70, 0x50, 0x6f, 0x6c, 0x69,
+	0x63, 0x79, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74,
+	0x2e, 0x44, 0x65, 0x73, 0x6b, 0x74, 0x6f, 0x70, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x0d,
+	0x64, 0x65, 0x73, 0x6b, 0x74, 0x6f, 0x70, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x22, 0x97, 0x02,
+	0x0a, 0x0d, 0x44, 0x65, 0x73, 0x6b, 0x74, 0x6f, 0x70, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12,
+	0x20, 0x0a, 0x0b, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x43, 0x6f, 0x70, 0x79, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x43, 0x6f, 0x70,
+	0x79, 0x12, 0x22, 0x0a, 0x0c, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x50, 0x61, 0x73, 0x74,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65,
+	0x50, 0x61, 0x73, 0x74, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65,
+	0x44, 0x72, 0x69, 0x76, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x64, 0x69, 0x73,
+	0x61, 0x62, 0x6c, 0x65, 0x44, 0x72, 0x69, 0x76, 0x65, 0x12, 0x2a, 0x0a, 0x10, 0x64, 0x69, 0x73,
+	0x61, 0x62, 0x6c, 0x65, 0x57, 0x61, 0x6c, 0x6c, 0x70, 0x61, 0x70, 0x65, 0x72, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x10, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x57, 0x61, 0x6c, 0x6c,
+	0x70, 0x61, 0x70, 0x65, 0x72, 0x12, 0x22, 0x0a, 0x0c, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65,
+	0x41, 0x75, 0x64, 0x69, 0x6f, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x64, 0x69, 0x73,
+	0x61, 0x62, 0x6c, 0x65, 0x41, 0x75, 0x64, 0x69, 0x6f, 0x12, 0x14, 0x0a, 0x05, 0x77, 0x69, 0x64,
+	0x74, 0x68, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x77, 0x69, 0x64, 0x74, 0x68, 0x12,
+	0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x6f, 0x6c, 0x6f, 0x72,
+	0x44, 0x65, 0x70, 0x74, 0x68, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x63, 0x6f, 0x6c,
+	0x6f, 0x72, 0x44, 0x65, 0x70, 0x74, 0x68, 0x22, 0x2d, 0x0a, 0x0f, 0x43, 0x6c, 0x6f, 0x73, 0x65,
+	0x45, 0x6e, 0x76, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x65, 0x76,
+	0x65, 0x6e, 0x74, 0x54, 0x61, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x65, 0x76,
+	0x65, 0x6e, 0x74, 0x54, 0x61, 0x67, 0x22, 0xd6, 0x03, 0x0a, 0x0f, 0x4c, 0x69, 0x73, 0x74, 0x45,
+	0x6e, 0x76, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x43, 0x0a, 0x09, 0x65, 0x76,
+	0x65, 0x6e, 0x74, 0x54, 0x61, 0x67, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x25, 0x2e,
+	0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x6e, 0x76, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x61, 0x67, 0x73, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x52, 0x09, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x61, 0x67, 0x73, 0x12,
+	0x5b, 0x0a, 0x11, 0x73, 0x74, 0x61, 0x72, 0x74, 0x69, 0x6e, 0x67, 0x45, 0x76, 0x65, 0x6e, 0x74,
+	0x54, 0x61, 0x67, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x61, 0x67, 0x65,
+	0x6e, 0x74, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x6e, 0x76, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x2e, 0x53, 0x74, 0x61, 0x72, 0x74, 0x69, 0x6e, 0x67, 0x45, 0x76, 0x65, 0x6e, 0x74,
+	0x54, 0x61, 0x67, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x11, 0x73, 0x74, 0x61, 0x72, 0x74,
+	0x69, 0x6e, 0x67, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x61, 0x67, 0x73, 0x12, 0x58, 0x0a, 0x10,
+	0x63, 0x6c, 0x6f, 0x73, 0x69, 0x6e, 0x67, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x61, 0x67, 0x73,
+	0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x4c,
+	0x69, 0x73, 0x74, 0x45, 0x6e, 0x76, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x43,
+	0x6c, 0x6f, 0x73, 0x69, 0x6e, 0x67, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x61, 0x67, 0x73, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x52, 0x10, 0x63, 0x6c, 0x6f, 0x73, 0x69, 0x6e, 0x67, 0x45, 0x76, 0x65,
+	0x6e, 0x74, 0x54, 0x61, 0x67, 0x73, 0x1a, 0x3c, 0x0a, 0x0e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54,
+	0x61, 0x67, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x3a, 0x02, 0x38, 0x01, 0x1a, 0x44, 0x0a, 0x16, 0x53, 0x74, 0x61, 0x72, 0x74, 0x69, 0x6e, 0x67,
+	0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x61, 0x67, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10,
+	0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79,
+	0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x43, 0x0a, 0x15, 0x43, 0x6c,
+	0x6f, 0x73, 0x69, 0x6e, 0x67, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x61, 0x67, 0x73, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22,
+	0x44, 0x0a, 0x10, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4c, 0x61, 0x62, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x61, 0x67, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x61, 0x67, 0x12,
+	0x14, 0x0a, 0x05, 0x69, 0x73, 0x56, 0x50, 0x4e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05,
+	0x69, 0x73, 0x56, 0x50, 0x4e, 0x22, 0x2e, 0x0a, 0x14, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x56,
+	0x70, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a,
+	0x06, 0x6c, 0x61, 0x62, 0x54, 0x61, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6c,
+	0x61, 0x62, 0x54, 0x61, 0x67, 0x22, 0x31, 0x0a, 0x15, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x56,
+	0x70, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18,
+	0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x07, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x22, 0x29, 0x0a, 0x0f, 0x43, 0x6c, 0x6f, 0x73,
+	0x65, 0x4c, 0x61, 0x62, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x6c,
+	0x61, 0x62, 0x54, 0x61, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6c, 0x61, 0x62,
+	0x54, 0x61, 0x67, 0x22, 0xbc, 0x01, 0x0a, 0x0f, 0x45, 0x78, 0x65, 0x72, 0x63, 0x69, 0x73, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x6c, 0x61, 0x62, 0x54, 0x61,
+	0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6c, 0x61, 0x62, 0x54, 0x61, 0x67, 0x12,
+	0x16, 0x0a, 0x06, 0x65, 0x6e, 0x76, 0x54, 0x61, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x65, 0x6e, 0x76, 0x54, 0x61, 0x67, 0x12, 0x1c, 0x0a, 0x09, 0x65, 0x78, 0x65, 0x72, 0x63,
+	0x69, 0x73, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x65, 0x78, 0x65, 0x72,
+	0x63, 0x69, 0x73, 0x65, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x65, 0x78, 0x65, 0x72, 0x63, 0x69, 0x73,
+	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x65, 0x78, 0x65, 0x72, 0x63, 0x69, 0x73,
+	0x65, 0x12, 0x3f, 0x0a, 0x0f, 0x65, 0x78, 0x65, 0x72, 0x63, 0x69, 0x73, 0x65, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x61, 0x67, 0x65,
+	0x6e, 0x74, 0x2e, 0x45, 0x78, 0x65, 0x72, 0x63, 0x69, 0x73, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x52, 0x0f, 0x65, 0x78, 0x65, 0x72, 0x63, 0x69, 0x73, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x73, 0x22, 0x4e, 0x0a, 0x08, 0x56, 0x6d, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x14,
+	0x0a, 0x05, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x69,
+	0x6d, 0x61, 0x67, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x4d, 0x42,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x4d, 0x42,
+	0x12, 0x10, 0x0a, 0x03, 0x63, 0x70, 0x75, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x03, 0x63,
+	0x70, 0x75, 0x22, 0x2a, 0x0a, 0x0e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0xd5,
+	0x02, 0x0a, 0x03, 0x4c, 0x61, 0x62, 0x12, 0x10, 0x0a, 0x03, 0x74, 0x61, 0x67, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x74, 0x61, 0x67, 0x12, 0x1a, 0x0a, 0x08, 0x65, 0x76, 0x65, 0x6e,
+	0x74, 0x54, 0x61, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x65, 0x76, 0x65, 0x6e,
+	0x74, 0x54, 0x61, 0x67, 0x12, 0x2d, 0x0a, 0x09, 0x65, 0x78, 0x65, 0x72, 0x63, 0x69, 0x73, 0x65,
+	0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e,
+	0x45, 0x78, 0x65, 0x72, 0x63, 0x69, 0x73, 0x65, 0x52, 0x09, 0x65, 0x78, 0x65, 0x72, 0x63, 0x69,
+	0x73, 0x65, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x73, 0x56, 0x50, 0x4e, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x05, 0x69, 0x73, 0x56, 0x50, 0x4e, 0x12, 0x2e, 0x0a, 0x09, 0x67, 0x75, 0x61,
+	0x63, 0x43, 0x72, 0x65, 0x64, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x61,
+	0x67, 0x65, 0x6e, 0x74, 0x2e, 0x47, 0x75, 0x61, 0x63, 0x43, 0x72, 0x65, 0x64, 0x73, 0x52, 0x09,
+	0x67, 0x75, 0x61, 0x63, 0x43, 0x72, 0x65, 0x64, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x76, 0x70, 0x6e,
+	0x43, 0x6f, 0x6e, 0x66, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x76, 0x70, 0x6e,
+	0x43, 0x6f, 0x6e, 0x66, 0x73, 0x12, 0x32, 0x0a, 0x0b, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x43,
+	0x72, 0x65, 0x64, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x61, 0x67, 0x65,
+	0x6e, 0x74, 0x2e, 0x47, 0x75, 0x61, 0x63, 0x43, 0x72, 0x65, 0x64, 0x73, 0x52, 0x0b, 0x6d, 0x65,
+	0x6d, 0x62, 0x65, 0x72, 0x43, 0x72, 0x65, 0x64, 0x73, 0x12, 0x3f, 0x0a, 0x0f, 0x65, 0x78, 0x70,
+	0x6f, 0x73, 0x65, 0x64, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x18, 0x08, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x15, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x73,
+	0x65, 0x64, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x52, 0x0f, 0x65, 0x78, 0x70, 0x6f, 0x73,
+	0x65, 0x64, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x74,
+	0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x61, 0x74,
+	0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x22, 0x58, 0x0a, 0x0e, 0x45, 0x78, 0x70, 0x6f, 0x73, 0x65,
+	0x64, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x65, 0x78, 0x65, 0x72,
+	0x63, 0x69, 0x73, 0x65, 0x54, 0x61, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x65,
+	0x78, 0x65, 0x72, 0x63, 0x69, 0x73, 0x65, 0x54, 0x61, 0x67, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x10,
+	0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x6c,
+	0x22, 0x86, 0x01, 0x0a, 0x08, 0x45, 0x78, 0x65, 0x72, 0x63, 0x69, 0x73, 0x65, 0x12, 0x10, 0x0a,
+	0x03, 0x74, 0x61, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x74, 0x61, 0x67, 0x12,
+	0x3c, 0x0a, 0x0e, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x45, 0x78, 0x65, 0x72, 0x63, 0x69, 0x73, 0x65,
+	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e,
+	0x43, 0x68, 0x69, 0x6c, 0x64, 0x45, 0x78, 0x65, 0x72, 0x63, 0x69, 0x73, 0x65, 0x52, 0x0e, 0x63,
+	0x68, 0x69, 0x6c, 0x64, 0x45, 0x78, 0x65, 0x72, 0x63, 0x69, 0x73, 0x65, 0x73, 0x12, 0x2a, 0x0a,
+	0x08, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x0e, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x52,
+	0x08, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x73, 0x22, 0x35, 0x0a, 0x0d, 0x43, 0x68, 0x69,
+	0x6c, 0x64, 0x45, 0x78, 0x65, 0x72, 0x63, 0x69, 0x73, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x74, 0x61,
+	0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x74, 0x61, 0x67, 0x12, 0x12, 0x0a, 0x04,
+	0x66, 0x6c, 0x61, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x66, 0x6c, 0x61, 0x67,
+	0x22, 0x73, 0x0a, 0x07, 0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x72, 0x72,
+	0x6f, 0x72, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x65, 0x72, 0x72, 0x6f, 0x72,
+	0x73, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x74, 0x79, 0x70, 0x65, 0x22, 0x83, 0x01, 0x0a, 0x09, 0x47, 0x75, 0x61, 0x63, 0x43, 0x72,
+	0x65, 0x64, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12,
+	0x1a, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x72,
+	0x64, 0x70, 0x50, 0x6f, 0x72, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x72, 0x64,
+	0x70, 0x50, 0x6f, 0x72, 0x74, 0x12, 0x24, 0x0a, 0x0d, 0x66, 0x72, 0x6f, 0x6e, 0x74, 0x65, 0x6e,
+	0x64, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x66, 0x72,
+	0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x64, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x22, 0x75, 0x0a, 0x0e, 0x45,
+	0x78, 0x65, 0x72, 0x63, 0x69, 0x73, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x10, 0x0a,
+	0x03, 0x74, 0x61, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x74, 0x61, 0x67, 0x12,
+	0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x69, 0x63, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x06, 0x73, 0x74, 0x61, 0x74, 0x69, 0x63, 0x12, 0x39, 0x0a, 0x08, 0x69, 0x6e, 0x73, 0x74, 0x61,
+	0x6e, 0x63, 0x65, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x61, 0x67, 0x65, 0x6e,
+	0x74, 0x2e, 0x45, 0x78, 0x65, 0x72, 0x63, 0x69, 0x73, 0x65, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e,
+	0x63, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x08, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e,
+	0x63, 0x65, 0x22, 0xbb, 0x02, 0x0a, 0x16, 0x45, 0x78, 0x65, 0x72, 0x63, 0x69, 0x73, 0x65, 0x49,
+	0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x14, 0x0a,
+	0x05, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x69, 0x6d,
+	0x61, 0x67, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x06, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x63,
+	0x70, 0x75, 0x18, 0x03, 0x20, 0x01, 0x28, 0x02, 0x52, 0x03, 0x63, 0x70, 0x75, 0x12, 0x27, 0x0a,
+	0x04, 0x65, 0x6e, 0x76, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x61, 0x67,
+	0x65, 0x6e, 0x74, 0x2e, 0x45, 0x6e, 0x76, 0x56, 0x61, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x52, 0x04, 0x65, 0x6e, 0x76, 0x73, 0x12, 0x35, 0x0a, 0x08, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x72,
+	0x65, 0x6e, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74,
+	0x2e, 0x43, 0x68, 0x69, 0x6c, 0x64, 0x72, 0x65, 0x6e, 0x43, 0x68, 0x61, 0x6c, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x52, 0x08, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x72, 0x65, 0x6e, 0x12, 0x2d, 0x0a,
+	0x07, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13,
+	0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x52, 0x07, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x12, 0x16, 0x0a, 0x06,
+	0x65, 0x78, 0x70, 0x6f, 0x73, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x65, 0x78,
+	0x70, 0x6f, 0x73, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x6f, 0x73, 0x65, 0x50, 0x6f,
+	0x72, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x65, 0x78, 0x70, 0x6f, 0x73, 0x65,
+	0x50, 0x6f, 0x72, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x61, 0x6c,
+	0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x74, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x61, 0x6c,
+	0x22, 0x38, 0x0a, 0x0c, 0x45, 0x6e, 0x76, 0x56, 0x61, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x6c, 0x0a, 0x12, 0x43, 0x68,
+	0x69, 0x6c, 0x64, 0x72, 0x65, 0x6e, 0x43, 0x68, 0x61, 0x6c, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x12, 0x10, 0x0a, 0x03, 0x74, 0x61, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x74,
+	0x61, 0x67, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e, 0x76, 0x46, 0x6c, 0x61,
+	0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x65, 0x6e, 0x76, 0x46, 0x6c, 0x61, 0x67,
+	0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x69, 0x63, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x69, 0x63, 0x22, 0x4a, 0x0a, 0x0c, 0x52, 0x65, 0x63, 0x6f,
+	0x72, 0x64, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x12, 0x0a, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x64, 0x61, 0x74, 0x61, 0x32, 0xb7, 0x0e, 0x0a, 0x05, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x12, 0x44,
+	0x0a, 0x11, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x45, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d,
+	0x65, 0x6e, 0x74, 0x12, 0x16, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x45, 0x6e, 0x76, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x61, 0x67,
+	0x65, 0x6e, 0x74, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x12, 0x43, 0x0a, 0x10, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x45, 0x6e, 0x76,
+	0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x16, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74,
+	0x2e, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x45, 0x6e, 0x76, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x15, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3a, 0x0a, 0x10, 0x4c, 0x69, 0x73,
+	0x74, 0x45, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x0c, 0x2e,
+	0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x16, 0x2e, 0x61, 0x67,
+	0x65, 0x6e, 0x74, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x6e, 0x76, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x43, 0x0a, 0x0f, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4c,
+	0x61, 0x62, 0x46, 0x6f, 0x72, 0x45, 0x6e, 0x76, 0x12, 0x17, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74,
+	0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4c, 0x61, 0x62, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x15, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x52, 0x0a, 0x13, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x56, 0x70, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x46, 0x6f, 0x72, 0x4c, 0x61,
+	0x62, 0x12, 0x1b, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x56, 0x70, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c,
+	0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x56, 0x70, 0x6e,
+	0x43, 0x6f, 0x6e, 0x66, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3b,
+	0x0a, 0x08, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x4c, 0x61, 0x62, 0x12, 0x16, 0x2e, 0x61, 0x67, 0x65,
+	0x6e, 0x74, 0x2e, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x4c, 0x61, 0x62, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x15, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x44, 0x0a, 0x11, 0x41,
+	0x64, 0x64, 0x45, 0x78, 0x65, 0x72, 0x63, 0x69, 0x73, 0x65, 0x73, 0x54, 0x6f, 0x45, 0x6e, 0x76,
+	0x12, 0x16, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x45, 0x78, 0x65, 0x72, 0x63, 0x69, 0x73,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74,
+	0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x00, 0x12, 0x44, 0x0a, 0x11, 0x41, 0x64, 0x64, 0x45, 0x78, 0x65, 0x72, 0x63, 0x69, 0x73, 0x65,
+	0x73, 0x54, 0x6f, 0x4c, 0x61, 0x62, 0x12, 0x16, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x45,
+	0x78, 0x65, 0x72, 0x63, 0x69, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15,
+	0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3b, 0x0a, 0x08, 0x52, 0x65, 0x73, 0x65, 0x74,
+	0x4c, 0x61, 0x62, 0x12, 0x16, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x52, 0x65, 0x73, 0x65,
+	0x74, 0x4c, 0x61, 0x62, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x61, 0x67,
+	0x65, 0x6e, 0x74, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x12, 0x45, 0x0a, 0x12, 0x52, 0x65, 0x73, 0x65, 0x74, 0x45, 0x78, 0x65,
+	0x72, 0x63, 0x69, 0x73, 0x65, 0x49, 0x6e, 0x4c, 0x61, 0x62, 0x12, 0x16, 0x2e, 0x61, 0x67, 0x65,
+	0x6e, 0x74, 0x2e, 0x45, 0x78, 0x65, 0x72, 0x63, 0x69, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x15, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x45, 0x0a, 0x12, 0x53,
+	0x74, 0x61, 0x72, 0x74, 0x45, 0x78, 0x65, 0x72, 0x63, 0x69, 0x73, 0x65, 0x49, 0x6e, 0x4c, 0x61,
+	0x62, 0x12, 0x16, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x45, 0x78, 0x65, 0x72, 0x63, 0x69,
+	0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x61, 0x67, 0x65, 0x6e,
+	0x74, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x12, 0x44, 0x0a, 0x11, 0x53, 0x74, 0x6f, 0x70, 0x45, 0x78, 0x65, 0x72, 0x63, 0x69,
+	0x73, 0x65, 0x49, 0x6e, 0x4c, 0x61, 0x62, 0x12, 0x16, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e,
+	0x45, 0x78, 0x65, 0x72, 0x63, 0x69, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x15, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x31, 0x0a, 0x04, 0x50, 0x69, 0x6e, 0x67,
+	0x12, 0x12, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x50, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x50, 0x69, 0x6e,
+	0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x41, 0x0a, 0x0d, 0x4d,
+	0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x12, 0x12, 0x2e, 0x61,
+	0x67, 0x65, 0x6e, 0x74, 0x2e, 0x50, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x16, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x4d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x28, 0x01, 0x30, 0x01, 0x12, 0x37,
+	0x0a, 0x06, 0x47, 0x65, 0x74, 0x4c, 0x61, 0x62, 0x12, 0x14, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74,
+	0x2e, 0x47, 0x65, 0x74, 0x4c, 0x61, 0x62, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15,
+	0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x4c, 0x61, 0x62, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x42, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x48, 0x6f,
+	0x73, 0x74, 0x73, 0x49, 0x6e, 0x4c, 0x61, 0x62, 0x12, 0x16, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74,
+	0x2e, 0x47, 0x65, 0x74, 0x48, 0x6f, 0x73, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x17, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x48, 0x6f, 0x73, 0x74,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x39, 0x0a, 0x0c, 0x52,
+	0x65, 0x73, 0x65, 0x74, 0x56, 0x6d, 0x49, 0x6e, 0x4c, 0x61, 0x62, 0x12, 0x10, 0x2e, 0x61, 0x67,
+	0x65, 0x6e, 0x74, 0x2e, 0x56, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e,
+	0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4a, 0x0a, 0x0e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65,
+	0x63, 0x6f, 0x72, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x17, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74,
+	0x2e, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x1d, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65,
+	0x63, 0x6f, 0x72, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x12, 0x47, 0x0a, 0x11, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x52, 0x65,
+	0x63, 0x6f, 0x72, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x17, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e,
+	0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x15, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x69,
+	0x6e, 0x67, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x22, 0x00, 0x30, 0x01, 0x12, 0x4e, 0x0a, 0x10, 0x43,
+	0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x69, 0x6e, 0x67, 0x12,
+	0x17, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x69, 0x6e,
+	0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74,
+	0x2e, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x69, 0x6e,
+	0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4c, 0x0a, 0x15, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x70, 0x65, 0x63, 0x74, 0x61, 0x74, 0x6f, 0x72, 0x41, 0x63,
+	0x63, 0x65, 0x73, 0x73, 0x12, 0x17, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x53, 0x70, 0x65,
+	0x63, 0x74, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e,
+	0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x53, 0x70, 0x65, 0x63, 0x74, 0x61, 0x74, 0x6f, 0x72, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x49, 0x0a, 0x14, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x54, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x61, 0x6c, 0x41, 0x63, 0x63, 0x65, 0x73,
+	0x73, 0x12, 0x16, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x54, 0x65, 0x72, 0x6d, 0x69, 0x6e,
+	0x61, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x61, 0x67, 0x65, 0x6e,
+	0x74, 0x2e, 0x54, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x61, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x12, 0x42, 0x0a, 0x0d, 0x51, 0x75, 0x65, 0x72, 0x79, 0x41, 0x75, 0x64,
+	0x69, 0x74, 0x4c, 0x6f, 0x67, 0x12, 0x16, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x41, 0x75,
+	0x64, 0x69, 0x74, 0x4c, 0x6f, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e,
+	0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x41, 0x75, 0x64, 0x69, 0x74, 0x4c, 0x6f, 0x67, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x38, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x41,
+	0x67, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x0c, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74,
+	0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x18, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x41,
+	0x67, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x12, 0x40, 0x0a, 0x09, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x61, 0x73, 0x6b, 0x73, 0x12,
+	0x17, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x61, 0x73, 0x6b,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74,
+	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x61, 0x73, 0x6b, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x12, 0x40, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x4c, 0x61, 0x62, 0x55, 0x73,
+	0x61, 0x67, 0x65, 0x12, 0x16, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x4c, 0x61, 0x62, 0x55,
+	0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x61, 0x67,
+	0x65, 0x6e, 0x74, 0x2e, 0x4c, 0x61, 0x62, 0x55, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x46, 0x0a, 0x0b, 0x53, 0x65, 0x74, 0x4c, 0x6f, 0x67,
+	0x4c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x19, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x53, 0x65,
+	0x74, 0x4c, 0x6f, 0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x1a, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x53, 0x65, 0x74, 0x4c, 0x6f, 0x67, 0x4c,
+	0x65, 0x76, 0x65, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x42, 0x36,
+	0x5a, 0x34, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x61, 0x61, 0x75,
+	0x2d, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2d, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74,
+	0x79, 0x2f, 0x68, 0x61, 0x61, 0x75, 0x6b, 0x69, 0x6e, 0x73, 0x2d, 0x61, 0x67, 0x65, 0x6e, 0x74,
+	0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_agent_proto_rawDescOnce sync.Once
+	file_agent_proto_rawDescData = file_agent_proto_rawDesc
+)
+
+func file_agent_proto_rawDescGZIP() []byte {
+	file_agent_proto_rawDescOnce.Do(func() {
+		file_agent_proto_rawDescData = protoimpl.X.CompressGZIP(file_agent_proto_rawDescData)
+	})
+	return file_agent_proto_rawDescData
+}
+
+var file_agent_proto_msgTypes = make([]protoimpl.MessageInfo, 64)
+var file_agent_proto_goTypes = []interface{}{
+	(*Empty)(nil),                    // 0: agent.Empty
+	(*VmRequest)(nil),                // 1: agent.VmRequest
+	(*RecordingRequest)(nil),         // 2: agent.RecordingRequest
+	(*Recording)(nil),                // 3: agent.Recording
+	(*ListRecordingsResponse)(nil),   // 4: agent.ListRecordingsResponse
+	(*RecordingChunk)(nil),           // 5: agent.RecordingChunk
+	(*ConvertRecordingResponse)(nil), // 6: agent.ConvertRecordingResponse
+	(*SpectatorRequest)(nil),         // 7: agent.SpectatorRequest
+	(*SpectatorResponse)(nil),        // 8: agent.SpectatorResponse
+	(*TerminalRequest)(nil),          // 9: agent.TerminalRequest
+	(*TerminalResponse)(nil),         // 10: agent.TerminalResponse
+	(*SetLogLevelRequest)(nil),       // 11: agent.SetLogLevelRequest
+	(*SetLogLevelResponse)(nil),      // 12: agent.SetLogLevelResponse
+	(*ListTasksRequest)(nil),         // 13: agent.ListTasksRequest
+	(*ListTasksResponse)(nil),        // 14: agent.ListTasksResponse
+	(*Task)(nil),                     // 15: agent.Task
+	(*AgentInfoResponse)(nil),        // 16: agent.AgentInfoResponse
+	(*AuditLogRequest)(nil),          // 17: agent.AuditLogRequest
+	(*AuditEntry)(nil),               // 18: agent.AuditEntry
+	(*AuditLogResponse)(nil),         // 19: agent.AuditLogResponse
+	(*ResetLabRequest)(nil),          // 20: agent.ResetLabRequest
+	(*GetLabRequest)(nil),            // 21: agent.GetLabRequest
+	(*GetLabResponse)(nil),           // 22: agent.GetLabResponse
+	(*GetHostsRequest)(nil),          // 23: agent.GetHostsRequest
+	(*GetHostsResponse)(nil),         // 24: agent.GetHostsResponse
+	(*MonitorResponse)(nil),          // 25: agent.MonitorResponse
+	(*Resources)(nil),                // 26: agent.Resources
+	(*DiskUsage)(nil),                // 27: agent.DiskUsage
+	(*LabUsage)(nil),                 // 28: agent.LabUsage
+	(*LabUsageRequest)(nil),          // 29: agent.LabUsageRequest
+	(*LabUsageResponse)(nil),         // 30: agent.LabUsageResponse
+	(*EventUsage)(nil),               // 31: agent.EventUsage
+	(*UsageTotals)(nil),              // 32: agent.UsageTotals
+	(*ResourceWarning)(nil),          // 33: agent.ResourceWarning
+	(*PingRequest)(nil),              // 34: agent.PingRequest
+	(*PingResponse)(nil),             // 35: agent.PingResponse
+	(*CreatEnvRequest)(nil),          // 36: agent.CreatEnvRequest
+	(*DesktopPolicy)(nil),            // 37: agent.DesktopPolicy
+	(*CloseEnvRequest)(nil),          // 38: agent.CloseEnvRequest
+	(*ListEnvResponse)(nil),          // 39: agent.ListEnvResponse
+	(*CreateLabRequest)(nil),         // 40: agent.CreateLabRequest
+	(*CreateVpnConfRequest)(nil),     // 41: agent.CreateVpnConfRequest
+	(*CreateVpnConfResponse)(nil),    // 42: agent.CreateVpnConfResponse
+	(*CloseLabRequest)(nil),          // 43: agent.CloseLabRequest
+	(*ExerciseRequest)(nil),          // 44: agent.ExerciseRequest
+	(*VmConfig)(nil),                 // 45: agent.VmConfig
+	(*StatusResponse)(nil),           // 46: agent.StatusResponse
+	(*Lab)(nil),                      // 47: agent.Lab
+	(*ExposedService)(nil),           // 48: agent.ExposedService
+	(*Exercise)(nil),                 // 49: agent.Exercise
+	(*ChildExercise)(nil),            // 50: agent.ChildExercise
+	(*Machine)(nil),                  // 51: agent.Machine
+	(*GuacCreds)(nil),                // 52: agent.GuacCreds
+	(*ExerciseConfig)(nil),           // 53: agent.ExerciseConfig
+	(*ExerciseInstanceConfig)(nil),   // 54: agent.ExerciseInstanceConfig
+	(*EnvVarConfig)(nil),             // 55: agent.EnvVarConfig
+	(*ChildrenChalConfig)(nil),       // 56: agent.ChildrenChalConfig
+	(*RecordConfig)(nil),             // 57: agent.RecordConfig
+	nil,                              // 58: agent.AgentInfoResponse.FeaturesEntry
+	nil,                              // 59: agent.MonitorResponse.QueuedTasksByEventEntry
+	nil,                              // 60: agent.MonitorResponse.LabsByEventEntry
+	nil,                              // 61: agent.ListEnvResponse.EventTagsEntry
+	nil,                              // 62: agent.ListEnvResponse.StartingEventTagsEntry
+	nil,                              // 63: agent.ListEnvResponse.ClosingEventTagsEntry
+}
+var file_agent_proto_depIdxs = []int32{
+	3,  // 0: agent.ListRecordingsResponse.recordings:type_name -> agent.Recording
+	3,  // 1: agent.ConvertRecordingResponse.recording:type_name -> agent.Recording
+	15, // 2: agent.ListTasksResponse.tasks:type_name -> agent.Task
+	58, // 3: agent.AgentInfoResponse.features:type_name -> agent.AgentInfoResponse.FeaturesEntry
+	18, // 4: agent.AuditLogResponse.entries:type_name -> agent.AuditEntry
+	47, // 5: agent.GetLabResponse.lab:type_name -> agent.Lab
+	47, // 6: agent.MonitorResponse.newLabs:type_name -> agent.Lab
+	26, // 7: agent.MonitorResponse.resources:type_name -> agent.Resources
+	59, // 8: agent.MonitorResponse.queuedTasksByEvent:type_name -> agent.MonitorResponse.QueuedTasksByEventEntry
+	60, // 9: agent.MonitorResponse.labsByEvent:type_name -> agent.MonitorResponse.LabsByEventEntry
+	28, // 10: agent.MonitorResponse.labUsage:type_name -> agent.LabUsage
+	33, // 11: agent.MonitorResponse.warnings:type_name -> agent.ResourceWarning
+	27, // 12: agent.Resources.disks:type_name -> agent.DiskUsage
+	28, // 13: agent.LabUsageResponse.labs:type_name -> agent.LabUsage
+	31, // 14: agent.LabUsageResponse.events:type_name -> agent.EventUsage
+	32, // 15: agent.EventUsage.totals:type_name -> agent.UsageTotals
+	45, // 16: agent.CreatEnvRequest.vm:type_name -> agent.VmConfig
+	53, // 17: agent.CreatEnvRequest.exerciseConfigs:type_name -> agent.ExerciseConfig
+	37, // 18: agent.CreatEnvRequest.desktopPolicy:type_name -> agent.DesktopPolicy
+	61, // 19: agent.ListEnvResponse.eventTags:type_name -> agent.ListEnvResponse.EventTagsEntry
+	62, // 20: agent.ListEnvResponse.startingEventTags:type_name -> agent.ListEnvResponse.StartingEventTagsEntry
+	63, // 21: agent.ListEnvResponse.closingEventTags:type_name -> agent.ListEnvResponse.ClosingEventTagsEntry
+	53, // 22: agent.ExerciseRequest.exerciseConfigs:type_name -> agent.ExerciseConfig
+	49, // 23: agent.Lab.exercises:type_name -> agent.Exercise
+	52, // 24: agent.Lab.guacCreds:type_name -> agent.GuacCreds
+	52, // 25: agent.Lab.memberCreds:type_name -> agent.GuacCreds
+	48, // 26: agent.Lab.exposedServices:type_name -> agent.ExposedService
+	50, // 27: agent.Exercise.childExercises:type_name -> agent.ChildExercise
+	51, // 28: agent.Exercise.machines:type_name -> agent.Machine
+	54, // 29: agent.ExerciseConfig.instance:type_name -> agent.ExerciseInstanceConfig
+	55, // 30: agent.ExerciseInstanceConfig.envs:type_name -> agent.EnvVarConfig
+	56, // 31: agent.ExerciseInstanceConfig.children:type_name -> agent.ChildrenChalConfig
+	57, // 32: agent.ExerciseInstanceConfig.records:type_name -> agent.RecordConfig
+	36, // 33: agent.Agent.CreateEnvironment:input_type -> agent.CreatEnvRequest
+	38, // 34: agent.Agent.CloseEnvironment:input_type -> agent.CloseEnvRequest
+	0,  // 35: agent.Agent.ListEnvironments:input_type -> agent.Empty
+	40, // 36: agent.Agent.CreateLabForEnv:input_type -> agent.CreateLabRequest
+	41, // 37: agent.Agent.CreateVpnConfForLab:input_type -> agent.CreateVpnConfRequest
+	43, // 38: agent.Agent.CloseLab:input_type -> agent.CloseLabRequest
+	44, // 39: agent.Agent.AddExercisesToEnv:input_type -> agent.ExerciseRequest
+	44, // 40: agent.Agent.AddExercisesToLab:input_type -> agent.ExerciseRequest
+	20, // 41: agent.Agent.ResetLab:input_type -> agent.ResetLabRequest
+	44, // 42: agent.Agent.ResetExerciseInLab:input_type -> agent.ExerciseRequest
+	44, // 43: agent.Agent.StartExerciseInLab:input_type -> agent.ExerciseRequest
+	44, // 44: agent.Agent.StopExerciseInLab:input_type -> agent.ExerciseRequest
+	34, // 45: agent.Agent.Ping:input_type -> agent.PingRequest
+	34, // 46: agent.Agent.MonitorStream:input_type -> agent.PingRequest
+	21, // 47: agent.Agent.GetLab:input_type -> agent.GetLabRequest
+	23, // 48: agent.Agent.GetHostsInLab:input_type -> agent.GetHostsRequest
+	1,  // 49: agent.Agent.ResetVmInLab:input_type -> agent.VmRequest
+	2,  // 50: agent.Agent.ListRecordings:input_type -> agent.RecordingRequest
+	2,  // 51: agent.Agent.DownloadRecording:input_type -> agent.RecordingRequest
+	2,  // 52: agent.Agent.ConvertRecording:input_type -> agent.RecordingRequest
+	7,  // 53: agent.Agent.CreateSpectatorAccess:input_type -> agent.SpectatorRequest
+	9,  // 54: agent.Agent.CreateTerminalAccess:input_type -> agent.TerminalRequest
+	17, // 55: agent.Agent.QueryAuditLog:input_type -> agent.AuditLogRequest
+	0,  // 56: agent.Agent.GetAgentInfo:input_type -> agent.Empty
+	13, // 57: agent.Agent.ListTasks:input_type -> agent.ListTasksRequest
+	29, // 58: agent.Agent.GetLabUsage:input_type -> agent.LabUsageRequest
+	11, // 59: agent.Agent.SetLogLevel:input_type -> agent.SetLogLevelRequest
+	46, // 60: agent.Agent.CreateEnvironment:output_type -> agent.StatusResponse
+	46, // 61: agent.Agent.CloseEnvironment:output_type -> agent.StatusResponse
+	39, // 62: agent.Agent.ListEnvironments:output_type -> agent.ListEnvResponse
+	46, // 63: agent.Agent.CreateLabForEnv:output_type -> agent.StatusResponse
+	42, // 64: agent.Agent.CreateVpnConfForLab:output_type -> agent.CreateVpnConfResponse
+	46, // 65: agent.Agent.CloseLab:output_type -> agent.StatusResponse
+	46, // 66: agent.Agent.AddExercisesToEnv:output_type -> agent.StatusResponse
+	46, // 67: agent.Agent.AddExercisesToLab:output_type -> agent.StatusResponse
+	46, // 68: agent.Agent.ResetLab:output_type -> agent.StatusResponse
+	46, // 69: agent.Agent.ResetExerciseInLab:output_type -> agent.StatusResponse
+	46, // 70: agent.Agent.StartExerciseInLab:output_type -> agent.StatusResponse
+	46, // 71: agent.Agent.StopExerciseInLab:output_type -> agent.StatusResponse
+	35, // 72: agent.Agent.Ping:output_type -> agent.PingResponse
+	25, // 73: agent.Agent.MonitorStream:output_type -> agent.MonitorResponse
+	22, // 74: agent.Agent.GetLab:output_type -> agent.GetLabResponse
+	24, // 75: agent.Agent.GetHostsInLab:output_type -> agent.GetHostsResponse
+	46, // 76: agent.Agent.ResetVmInLab:output_type -> agent.StatusResponse
+	4,  // 77: agent.Agent.ListRecordings:output_type -> agent.ListRecordingsResponse
+	5,  // 78: agent.Agent.DownloadRecording:output_type -> agent.RecordingChunk
+	6,  // 79: agent.Agent.ConvertRecording:output_type -> agent.ConvertRecordingResponse
+	8,  // 80: agent.Agent.CreateSpectatorAccess:output_type -> agent.SpectatorResponse
+	10, // 81: agent.Agent.CreateTerminalAccess:output_type -> agent.TerminalResponse
+	19, // 82: agent.Agent.QueryAuditLog:output_type -> agent.AuditLogResponse
+	16, // 83: agent.Agent.GetAgentInfo:output_type -> agent.AgentInfoResponse
+	14, // 84: agent.Agent.ListTasks:output_type -> agent.ListTasksResponse
+	30, // 85: agent.Agent.GetLabUsage:output_type -> agent.LabUsageResponse
+	12, // 86: agent.Agent.SetLogLevel:output_type -> agent.SetLogLevelResponse
+	60, // [60:87] is the sub-list for method output_type
+	33, // [33:60] is the sub-list for method input_type
+	33, // [33:33] is the sub-list for extension type_name
+	33, // [33:33] is the sub-list for extension extendee
+	0,  // [0:33] is the sub-list for field type_name
+}
+
+func init() { file_agent_proto_init() }
+func file_agent_proto_init() {
+	if File_agent_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_agent_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Empty); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_agent_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VmRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_agent_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RecordingRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_agent_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Recording); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_agent_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListRecordingsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_agent_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RecordingChunk); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_agent_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ConvertRecordingResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_agent_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SpectatorRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_agent_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SpectatorResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_agent_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TerminalRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_agent_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TerminalResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_agent_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetLogLevelRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_agent_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetLogLevelResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_agent_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListTasksRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_agent_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListTasksResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_agent_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Task); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2298,8 +5036,8 @@ func file_agent_proto_init() {
 				return nil
 			}
 		}
-		file_agent_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*VmRequest); i {
+		file_agent_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AgentInfoResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2310,7 +5048,43 @@ func file_agent_proto_init() {
 				return nil
 			}
 		}
-		file_agent_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+		file_agent_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AuditLogRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_agent_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AuditEntry); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_agent_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AuditLogResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_agent_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ResetLabRequest); i {
 			case 0:
 				return &v.state
@@ -2322,7 +5096,7 @@ func file_agent_proto_init() {
 				return nil
 			}
 		}
-		file_agent_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+		file_agent_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetLabRequest); i {
 			case 0:
 				return &v.state
@@ -2334,7 +5108,7 @@ func file_agent_proto_init() {
 				return nil
 			}
 		}
-		file_agent_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+		file_agent_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetLabResponse); i {
 			case 0:
 				return &v.state
@@ -2346,7 +5120,7 @@ func file_agent_proto_init() {
 				return nil
 			}
 		}
-		file_agent_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+		file_agent_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetHostsRequest); i {
 			case 0:
 				return &v.state
@@ -2358,7 +5132,7 @@ func file_agent_proto_init() {
 				return nil
 			}
 		}
-		file_agent_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+		file_agent_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetHostsResponse); i {
 			case 0:
 				return &v.state
@@ -2370,7 +5144,7 @@ func file_agent_proto_init() {
 				return nil
 			}
 		}
-		file_agent_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+		file_agent_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*MonitorResponse); i {
 			case 0:
 				return &v.state
@@ -2382,7 +5156,7 @@ func file_agent_proto_init() {
 				return nil
 			}
 		}
-		file_agent_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+		file_agent_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Resources); i {
 			case 0:
 				return &v.state
@@ -2394,7 +5168,91 @@ func file_agent_proto_init() {
 				return nil
 			}
 		}
-		file_agent_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+		file_agent_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DiskUsage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_agent_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LabUsage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_agent_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LabUsageRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_agent_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LabUsageResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_agent_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EventUsage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_agent_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UsageTotals); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_agent_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResourceWarning); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_agent_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*PingRequest); i {
 			case 0:
 				return &v.state
@@ -2406,7 +5264,7 @@ func file_agent_proto_init() {
 				return nil
 			}
 		}
-		file_agent_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+		file_agent_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*PingResponse); i {
 			case 0:
 				return &v.state
@@ -2418,7 +5276,7 @@ func file_agent_proto_init() {
 				return nil
 			}
 		}
-		file_agent_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+		file_agent_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*CreatEnvRequest); i {
 			case 0:
 				return &v.state
@@ -2430,7 +5288,19 @@ func file_agent_proto_init() {
 				return nil
 			}
 		}
-		file_agent_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+		file_agent_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DesktopPolicy); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_agent_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*CloseEnvRequest); i {
 			case 0:
 				return &v.state
@@ -2442,7 +5312,7 @@ func file_agent_proto_init() {
 				return nil
 			}
 		}
-		file_agent_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+		file_agent_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ListEnvResponse); i {
 			case 0:
 				return &v.state
@@ -2454,7 +5324,7 @@ func file_agent_proto_init() {
 				return nil
 			}
 		}
-		file_agent_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+		file_agent_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*CreateLabRequest); i {
 			case 0:
 				return &v.state
@@ -2466,7 +5336,7 @@ func file_agent_proto_init() {
 				return nil
 			}
 		}
-		file_agent_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+		file_agent_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*CreateVpnConfRequest); i {
 			case 0:
 				return &v.state
@@ -2478,7 +5348,7 @@ func file_agent_proto_init() {
 				return nil
 			}
 		}
-		file_agent_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+		file_agent_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*CreateVpnConfResponse); i {
 			case 0:
 				return &v.state
@@ -2490,7 +5360,7 @@ func file_agent_proto_init() {
 				return nil
 			}
 		}
-		file_agent_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+		file_agent_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*CloseLabRequest); i {
 			case 0:
 				return &v.state
@@ -2502,7 +5372,7 @@ func file_agent_proto_init() {
 				return nil
 			}
 		}
-		file_agent_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+		file_agent_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ExerciseRequest); i {
 			case 0:
 				return &v.state
@@ -2514,7 +5384,7 @@ func file_agent_proto_init() {
 				return nil
 			}
 		}
-		file_agent_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+		file_agent_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*VmConfig); i {
 			case 0:
 				return &v.state
@@ -2526,7 +5396,7 @@ func file_agent_proto_init() {
 				return nil
 			}
 		}
-		file_agent_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+		file_agent_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*StatusResponse); i {
 			case 0:
 				return &v.state
@@ -2538,7 +5408,7 @@ func file_agent_proto_init() {
 				return nil
 			}
 		}
-		file_agent_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+		file_agent_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Lab); i {
 			case 0:
 				return &v.state
@@ -2550,7 +5420,19 @@ func file_agent_proto_init() {
 				return nil
 			}
 		}
-		file_agent_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+		file_agent_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExposedService); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_agent_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Exercise); i {
 			case 0:
 				return &v.state
@@ -2562,7 +5444,7 @@ func file_agent_proto_init() {
 				return nil
 			}
 		}
-		file_agent_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+		file_agent_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ChildExercise); i {
 			case 0:
 				return &v.state
@@ -2574,7 +5456,7 @@ func file_agent_proto_init() {
 				return nil
 			}
 		}
-		file_agent_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+		file_agent_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Machine); i {
 			case 0:
 				return &v.state
@@ -2586,7 +5468,7 @@ func file_agent_proto_init() {
 				return nil
 			}
 		}
-		file_agent_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+		file_agent_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GuacCreds); i {
 			case 0:
 				return &v.state
@@ -2598,7 +5480,7 @@ func file_agent_proto_init() {
 				return nil
 			}
 		}
-		file_agent_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+		file_agent_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ExerciseConfig); i {
 			case 0:
 				return &v.state
@@ -2610,7 +5492,7 @@ func file_agent_proto_init() {
 				return nil
 			}
 		}
-		file_agent_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+		file_agent_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ExerciseInstanceConfig); i {
 			case 0:
 				return &v.state
@@ -2622,7 +5504,7 @@ func file_agent_proto_init() {
 				return nil
 			}
 		}
-		file_agent_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+		file_agent_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*EnvVarConfig); i {
 			case 0:
 				return &v.state
@@ -2634,7 +5516,7 @@ func file_agent_proto_init() {
 				return nil
 			}
 		}
-		file_agent_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+		file_agent_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ChildrenChalConfig); i {
 			case 0:
 				return &v.state
@@ -2646,7 +5528,7 @@ func file_agent_proto_init() {
 				return nil
 			}
 		}
-		file_agent_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+		file_agent_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*RecordConfig); i {
 			case 0:
 				return &v.state
@@ -2665,7 +5547,7 @@ func file_agent_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_agent_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   34,
+			NumMessages:   64,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -39,6 +39,16 @@ type AgentClient interface {
 	GetLab(ctx context.Context, in *GetLabRequest, opts ...grpc.CallOption) (*GetLabResponse, error)
 	GetHostsInLab(ctx context.Context, in *GetHostsRequest, opts ...grpc.CallOption) (*GetHostsResponse, error)
 	ResetVmInLab(ctx context.Context, in *VmRequest, opts ...grpc.CallOption) (*StatusResponse, error)
+	ListRecordings(ctx context.Context, in *RecordingRequest, opts ...grpc.CallOption) (*ListRecordingsResponse, error)
+	DownloadRecording(ctx context.Context, in *RecordingRequest, opts ...grpc.CallOption) (Agent_DownloadRecordingClient, error)
+	ConvertRecording(ctx context.Context, in *RecordingRequest, opts ...grpc.CallOption) (*ConvertRecordingResponse, error)
+	CreateSpectatorAccess(ctx context.Context, in *SpectatorRequest, opts ...grpc.CallOption) (*SpectatorResponse, error)
+	CreateTerminalAccess(ctx context.Context, in *TerminalRequest, opts ...grpc.CallOption) (*TerminalResponse, error)
+	QueryAuditLog(ctx context.Context, in *AuditLogRequest, opts ...grpc.CallOption) (*AuditLogResponse, error)
+	GetAgentInfo(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*AgentInfoResponse, error)
+	ListTasks(ctx context.Context, in *ListTasksRequest, opts ...grpc.CallOption) (*ListTasksResponse, error)
+	GetLabUsage(ctx context.Context, in *LabUsageRequest, opts ...grpc.CallOption) (*LabUsageResponse, error)
+	SetLogLevel(ctx context.Context, in *SetLogLevelRequest, opts ...grpc.CallOption) (*SetLogLevelResponse, error)
 }
 
 type agentClient struct {
@@ -224,6 +234,119 @@ func (c *agentClient) ResetVmInLab(ctx context.Context, in *VmRequest, opts ...g
 	return out, nil
 }
 
+func (c *agentClient) ListRecordings(ctx context.Context, in *RecordingRequest, opts ...grpc.CallOption) (*ListRecordingsResponse, error) {
+	out := new(ListRecordingsResponse)
+	err := c.cc.Invoke(ctx, "/agent.Agent/ListRecordings", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *agentClient) DownloadRecording(ctx context.Context, in *RecordingRequest, opts ...grpc.CallOption) (Agent_DownloadRecordingClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Agent_ServiceDesc.Streams[1], "/agent.Agent/DownloadRecording", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &agentDownloadRecordingClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Agent_DownloadRecordingClient interface {
+	Recv() (*RecordingChunk, error)
+	grpc.ClientStream
+}
+
+type agentDownloadRecordingClient struct {
+	grpc.ClientStream
+}
+
+func (x *agentDownloadRecordingClient) Recv() (*RecordingChunk, error) {
+	m := new(RecordingChunk)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *agentClient) ConvertRecording(ctx context.Context, in *RecordingRequest, opts ...grpc.CallOption) (*ConvertRecordingResponse, error) {
+	out := new(ConvertRecordingResponse)
+	err := c.cc.Invoke(ctx, "/agent.Agent/ConvertRecording", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *agentClient) CreateSpectatorAccess(ctx context.Context, in *SpectatorRequest, opts ...grpc.CallOption) (*SpectatorResponse, error) {
+	out := new(SpectatorResponse)
+	err := c.cc.Invoke(ctx, "/agent.Agent/CreateSpectatorAccess", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *agentClient) CreateTerminalAccess(ctx context.Context, in *TerminalRequest, opts ...grpc.CallOption) (*TerminalResponse, error) {
+	out := new(TerminalResponse)
+	err := c.cc.Invoke(ctx, "/agent.Agent/CreateTerminalAccess", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *agentClient) QueryAuditLog(ctx context.Context, in *AuditLogRequest, opts ...grpc.CallOption) (*AuditLogResponse, error) {
+	out := new(AuditLogResponse)
+	err := c.cc.Invoke(ctx, "/agent.Agent/QueryAuditLog", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *agentClient) GetAgentInfo(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*AgentInfoResponse, error) {
+	out := new(AgentInfoResponse)
+	err := c.cc.Invoke(ctx, "/agent.Agent/GetAgentInfo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *agentClient) ListTasks(ctx context.Context, in *ListTasksRequest, opts ...grpc.CallOption) (*ListTasksResponse, error) {
+	out := new(ListTasksResponse)
+	err := c.cc.Invoke(ctx, "/agent.Agent/ListTasks", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *agentClient) GetLabUsage(ctx context.Context, in *LabUsageRequest, opts ...grpc.CallOption) (*LabUsageResponse, error) {
+	out := new(LabUsageResponse)
+	err := c.cc.Invoke(ctx, "/agent.Agent/GetLabUsage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *agentClient) SetLogLevel(ctx context.Context, in *SetLogLevelRequest, opts ...grpc.CallOption) (*SetLogLevelResponse, error) {
+	out := new(SetLogLevelResponse)
+	err := c.cc.Invoke(ctx, "/agent.Agent/SetLogLevel", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // AgentServer is the server API for Agent service.
 // All implementations must embed UnimplementedAgentServer
 // for forward compatibility
@@ -245,6 +368,16 @@ type AgentServer interface {
 	GetLab(context.Context, *GetLabRequest) (*GetLabResponse, error)
 	GetHostsInLab(context.Context, *GetHostsRequest) (*GetHostsResponse, error)
 	ResetVmInLab(context.Context, *VmRequest) (*StatusResponse, error)
+	ListRecordings(context.Context, *RecordingRequest) (*ListRecordingsResponse, error)
+	DownloadRecording(*RecordingRequest, Agent_DownloadRecordingServer) error
+	ConvertRecording(context.Context, *RecordingRequest) (*ConvertRecordingResponse, error)
+	CreateSpectatorAccess(context.Context, *SpectatorRequest) (*SpectatorResponse, error)
+	CreateTerminalAccess(context.Context, *TerminalRequest) (*TerminalResponse, error)
+	QueryAuditLog(context.Context, *AuditLogRequest) (*AuditLogResponse, error)
+	GetAgentInfo(context.Context, *Empty) (*AgentInfoResponse, error)
+	ListTasks(context.Context, *ListTasksRequest) (*ListTasksResponse, error)
+	GetLabUsage(context.Context, *LabUsageRequest) (*LabUsageResponse, error)
+	SetLogLevel(context.Context, *SetLogLevelRequest) (*SetLogLevelResponse, error)
 	mustEmbedUnimplementedAgentServer()
 }
 
@@ -303,6 +436,36 @@ func (UnimplementedAgentServer) GetHostsInLab(context.Context, *GetHostsRequest)
 func (UnimplementedAgentServer) ResetVmInLab(context.Context, *VmRequest) (*StatusResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ResetVmInLab not implemented")
 }
+func (UnimplementedAgentServer) ListRecordings(context.Context, *RecordingRequest) (*ListRecordingsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListRecordings not implemented")
+}
+func (UnimplementedAgentServer) DownloadRecording(*RecordingRequest, Agent_DownloadRecordingServer) error {
+	return status.Errorf(codes.Unimplemented, "method DownloadRecording not implemented")
+}
+func (UnimplementedAgentServer) ConvertRecording(context.Context, *RecordingRequest) (*ConvertRecordingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConvertRecording not implemented")
+}
+func (UnimplementedAgentServer) CreateSpectatorAccess(context.Context, *SpectatorRequest) (*SpectatorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateSpectatorAccess not implemented")
+}
+func (UnimplementedAgentServer) CreateTerminalAccess(context.Context, *TerminalRequest) (*TerminalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateTerminalAccess not implemented")
+}
+func (UnimplementedAgentServer) QueryAuditLog(context.Context, *AuditLogRequest) (*AuditLogResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryAuditLog not implemented")
+}
+func (UnimplementedAgentServer) GetAgentInfo(context.Context, *Empty) (*AgentInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAgentInfo not implemented")
+}
+func (UnimplementedAgentServer) ListTasks(context.Context, *ListTasksRequest) (*ListTasksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTasks not implemented")
+}
+func (UnimplementedAgentServer) GetLabUsage(context.Context, *LabUsageRequest) (*LabUsageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLabUsage not implemented")
+}
+func (UnimplementedAgentServer) SetLogLevel(context.Context, *SetLogLevelRequest) (*SetLogLevelResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetLogLevel not implemented")
+}
 func (UnimplementedAgentServer) mustEmbedUnimplementedAgentServer() {}
 
 // UnsafeAgentServer may be embedded to opt out of forward compatibility for this service.
@@ -630,6 +793,189 @@ func _Agent_ResetVmInLab_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Agent_ListRecordings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RecordingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AgentServer).ListRecordings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/agent.Agent/ListRecordings",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgentServer).ListRecordings(ctx, req.(*RecordingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Agent_DownloadRecording_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(RecordingRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(AgentServer).DownloadRecording(m, &agentDownloadRecordingServer{stream})
+}
+
+type Agent_DownloadRecordingServer interface {
+	Send(*RecordingChunk) error
+	grpc.ServerStream
+}
+
+type agentDownloadRecordingServer struct {
+	grpc.ServerStream
+}
+
+func (x *agentDownloadRecordingServer) Send(m *RecordingChunk) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Agent_ConvertRecording_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RecordingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AgentServer).ConvertRecording(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/agent.Agent/ConvertRecording",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgentServer).ConvertRecording(ctx, req.(*RecordingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Agent_CreateSpectatorAccess_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SpectatorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AgentServer).CreateSpectatorAccess(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/agent.Agent/CreateSpectatorAccess",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgentServer).CreateSpectatorAccess(ctx, req.(*SpectatorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Agent_CreateTerminalAccess_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TerminalRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AgentServer).CreateTerminalAccess(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/agent.Agent/CreateTerminalAccess",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgentServer).CreateTerminalAccess(ctx, req.(*TerminalRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Agent_QueryAuditLog_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AuditLogRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AgentServer).QueryAuditLog(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/agent.Agent/QueryAuditLog",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgentServer).QueryAuditLog(ctx, req.(*AuditLogRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Agent_GetAgentInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AgentServer).GetAgentInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/agent.Agent/GetAgentInfo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgentServer).GetAgentInfo(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Agent_ListTasks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTasksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AgentServer).ListTasks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/agent.Agent/ListTasks",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgentServer).ListTasks(ctx, req.(*ListTasksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Agent_GetLabUsage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LabUsageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AgentServer).GetLabUsage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/agent.Agent/GetLabUsage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgentServer).GetLabUsage(ctx, req.(*LabUsageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Agent_SetLogLevel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetLogLevelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AgentServer).SetLogLevel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/agent.Agent/SetLogLevel",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgentServer).SetLogLevel(ctx, req.(*SetLogLevelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Agent_ServiceDesc is the grpc.ServiceDesc for Agent service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -701,6 +1047,42 @@ var Agent_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ResetVmInLab",
 			Handler:    _Agent_ResetVmInLab_Handler,
 		},
+		{
+			MethodName: "ListRecordings",
+			Handler:    _Agent_ListRecordings_Handler,
+		},
+		{
+			MethodName: "ConvertRecording",
+			Handler:    _Agent_ConvertRecording_Handler,
+		},
+		{
+			MethodName: "CreateSpectatorAccess",
+			Handler:    _Agent_CreateSpectatorAccess_Handler,
+		},
+		{
+			MethodName: "CreateTerminalAccess",
+			Handler:    _Agent_CreateTerminalAccess_Handler,
+		},
+		{
+			MethodName: "QueryAuditLog",
+			Handler:    _Agent_QueryAuditLog_Handler,
+		},
+		{
+			MethodName: "GetAgentInfo",
+			Handler:    _Agent_GetAgentInfo_Handler,
+		},
+		{
+			MethodName: "ListTasks",
+			Handler:    _Agent_ListTasks_Handler,
+		},
+		{
+			MethodName: "GetLabUsage",
+			Handler:    _Agent_GetLabUsage_Handler,
+		},
+		{
+			MethodName: "SetLogLevel",
+			Handler:    _Agent_SetLogLevel_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -709,6 +1091,11 @@ var Agent_ServiceDesc = grpc.ServiceDesc{
 			ServerStreams: true,
 			ClientStreams: true,
 		},
+		{
+			StreamName:    "DownloadRecording",
+			Handler:       _Agent_DownloadRecording_Handler,
+			ServerStreams: true,
+		},
 	},
 	Metadata: "agent.proto",
 }